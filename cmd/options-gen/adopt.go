@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+)
+
+// runAdopt runs the adopt subcommand and returns the exit code.
+func runAdopt(args []string) int {
+	flags := flag.NewFlagSet("adopt", flag.ContinueOnError)
+
+	var (
+		inFilename        string
+		optionsStructName string
+		outPrefix         string
+		outSetterName     string
+		naming            string
+		initialisms       string
+		setterPrefix      string
+	)
+
+	flags.StringVar(&inFilename,
+		"filename", os.Getenv("GOFILE"),
+		"input filename")
+	flags.StringVar(&optionsStructName,
+		"from-struct", "",
+		"struct that contains options")
+	flags.StringVar(&optionsStructName,
+		"struct", "",
+		"alias for -from-struct")
+	flags.StringVar(&outPrefix,
+		"out-prefix", "",
+		"prefix for generated structs and functions")
+	flags.StringVar(&outSetterName,
+		"out-setter-name", "",
+		"name of the option type")
+	flags.StringVar(&naming,
+		"naming", string(optionsgen.NamingTitle),
+		"naming strategy for setters")
+	flags.StringVar(&initialisms,
+		"initialisms", "",
+		"list of project-specific initialisms for the go naming strategy, comma-separated")
+	flags.StringVar(&setterPrefix,
+		"setter-prefix", "With",
+		"prefix for setter names. Can be empty")
+
+	if err := flags.Parse(args); err != nil {
+		return 2 //nolint:mnd
+	}
+
+	if isEmpty(inFilename, optionsStructName) {
+		flags.Usage()
+		//nolint:forbidigo
+		fmt.Println("missed required options")
+
+		return 2 //nolint:mnd
+	}
+
+	res, err := optionsgen.Adopt(optionsgen.NewOptions(
+		optionsgen.WithInFilename(inFilename),
+		optionsgen.WithStructName(optionsStructName),
+		optionsgen.WithOutPrefix(outPrefix),
+		optionsgen.WithOutOptionTypeName(outSetterName),
+		optionsgen.WithNaming(optionsgen.Naming(naming)),
+		optionsgen.WithInitialisms(splitInitialisms(initialisms)...),
+		optionsgen.WithSetterPrefix(setterPrefix),
+	))
+	for _, warning := range res.Warnings {
+		//nolint:forbidigo
+		fmt.Println(warning)
+	}
+
+	for _, setter := range res.Setters {
+		//nolint:forbidigo
+		fmt.Printf("adopted %s of `%s`\n", setter.Name, setter.Field)
+	}
+
+	for _, filename := range res.Files {
+		//nolint:forbidigo
+		fmt.Println("fixed", filename)
+	}
+
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println(err.Error())
+
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+)
+
+// runAPIDiff runs the apidiff subcommand and returns the exit code: 1 for
+// breaking changes, 2 for errors.
+func runAPIDiff(args []string) int {
+	flags := flag.NewFlagSet("apidiff", flag.ContinueOnError)
+
+	var (
+		base              string
+		inFilename        string
+		optionsStructName string
+		defaultsFrom      string
+		outPrefix         string
+		allVariadic       bool
+		exclude           string
+		naming            string
+		initialisms       string
+		setterPrefix      string
+		style             string
+		format            string
+	)
+
+	flags.StringVar(&base,
+		"base", "",
+		"base revision: a directory with the package or a git revision")
+	flags.StringVar(&inFilename,
+		"filename", os.Getenv("GOFILE"),
+		"input filename")
+	flags.StringVar(&optionsStructName,
+		"from-struct", "",
+		"struct that contains options")
+	flags.StringVar(&defaultsFrom,
+		"defaults-from", "tag=default",
+		"where to get defaults for options. Only defaults from tag and file are compared")
+	flags.StringVar(&outPrefix,
+		"out-prefix", "",
+		"prefix for generated structs and functions")
+	flags.BoolVar(&allVariadic,
+		"all-variadic", false,
+		"generate variadic functions")
+	flags.StringVar(&exclude, "exclude", "", "list of masks for field names excluded from generation, semicolon-separated")
+	flags.StringVar(&naming,
+		"naming", string(optionsgen.NamingTitle),
+		"naming strategy for setters")
+	flags.StringVar(&initialisms,
+		"initialisms", "",
+		"list of project-specific initialisms for the go naming strategy, comma-separated")
+	flags.StringVar(&setterPrefix,
+		"setter-prefix", "With",
+		"prefix for setter names. Can be empty")
+	flags.StringVar(&style,
+		"style", string(optionsgen.StyleFunctional),
+		"style of the generated API")
+	flags.StringVar(&format,
+		"format", string(optionsgen.APIDiffFormatText),
+		"output format. Possible values: "+strings.Join([]string{
+			string(optionsgen.APIDiffFormatText),
+			string(optionsgen.APIDiffFormatJSON),
+		}, ", ")+".")
+
+	if err := flags.Parse(args); err != nil {
+		return 2 //nolint:mnd
+	}
+
+	if isEmpty(base, inFilename, optionsStructName, defaultsFrom) {
+		flags.Usage()
+		//nolint:forbidigo
+		fmt.Println("missed required options")
+
+		return 2 //nolint:mnd
+	}
+
+	changes, err := apiDiff(base, inFilename, optionsStructName, defaultsFrom, exclude,
+		optionsgen.WithOutPrefix(outPrefix),
+		optionsgen.WithAllVariadic(allVariadic),
+		optionsgen.WithNaming(optionsgen.Naming(naming)),
+		optionsgen.WithInitialisms(splitInitialisms(initialisms)...),
+		optionsgen.WithSetterPrefix(setterPrefix),
+		optionsgen.WithStyle(optionsgen.Style(style)),
+	)
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println("cannot compare API", err.Error())
+
+		return 2 //nolint:mnd
+	}
+
+	out, err := optionsgen.FormatAPIChanges(changes, optionsgen.APIDiffFormat(format))
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println("cannot format changes", err.Error())
+
+		return 2 //nolint:mnd
+	}
+
+	_, _ = os.Stdout.Write(out)
+
+	if optionsgen.HasBreakingChanges(changes) {
+		return 1
+	}
+
+	return 0
+}
+
+func apiDiff(
+	base, inFilename, structName, defaultsFrom, exclude string,
+	setters ...optionsgen.OptOptionsSetter,
+) ([]optionsgen.APIChange, error) {
+	defaults, err := parseDefaults(defaultsFrom)
+	if err != nil {
+		return nil, fmt.Errorf("bad defaults spec: %w", err)
+	}
+
+	excludes, err := splitExcludes(exclude)
+	if err != nil {
+		return nil, fmt.Errorf("parse excludes: %w", err)
+	}
+
+	baseFilename, cleanup, err := resolveBaseFile(base, inFilename)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	opts := optionsgen.NewOptions(append([]optionsgen.OptOptionsSetter{
+		optionsgen.WithInFilename(inFilename),
+		optionsgen.WithStructName(structName),
+		optionsgen.WithDefaults(*defaults),
+		optionsgen.WithExclude(excludes...),
+	}, setters...)...)
+
+	return optionsgen.APIDiff(opts, baseFilename) //nolint:wrapcheck
+}
+
+// resolveBaseFile returns the path of the input file in the base revision.
+// The base is a directory with the package or a git revision, which is
+// checked out to a temporary worktree to keep imports of the module working.
+func resolveBaseFile(base, inFilename string) (string, func(), error) {
+	if info, err := os.Stat(base); err == nil && info.IsDir() {
+		return filepath.Join(base, filepath.Base(inFilename)), func() {}, nil
+	}
+
+	dir := filepath.Dir(inFilename)
+
+	prefix, err := git(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return "", nil, fmt.Errorf("base is neither a directory nor a git revision: %w", err)
+	}
+
+	// NOTE: the base is resolved to a commit first, so it cannot be taken
+	// for an option of git worktree.
+	commit, err := git(dir, "rev-parse", "--verify", "--end-of-options", base+"^{commit}")
+	if err != nil {
+		return "", nil, fmt.Errorf("base is neither a directory nor a git revision: %w", err)
+	}
+
+	worktree, err := os.MkdirTemp("", "options-gen-apidiff-")
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot create worktree dir: %w", err)
+	}
+
+	if _, err := git(dir, "worktree", "add", "--detach", worktree, commit); err != nil {
+		_ = os.RemoveAll(worktree)
+
+		return "", nil, fmt.Errorf("cannot checkout base revision: %w", err)
+	}
+
+	cleanup := func() {
+		_, _ = git(dir, "worktree", "remove", "--force", worktree)
+		_ = os.RemoveAll(worktree)
+	}
+
+	return filepath.Join(worktree, filepath.FromSlash(prefix), filepath.Base(inFilename)), cleanup, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", errors.New(msg)
+		}
+
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
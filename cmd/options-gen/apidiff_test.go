@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolveBaseFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directory", func(t *testing.T) {
+		t.Parallel()
+
+		base := t.TempDir()
+		filename, cleanup, err := resolveBaseFile(base, filepath.Join("pkg", "options.go"))
+		require.NoError(t, err)
+		defer cleanup()
+
+		assert.Equal(t, filepath.Join(base, "options.go"), filename)
+	})
+
+	t.Run("git_revision", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := exec.LookPath("git"); err != nil {
+			t.Skip("git is not installed")
+		}
+
+		repo := t.TempDir()
+		pkgDir := filepath.Join(repo, "pkg")
+		require.NoError(t, os.Mkdir(pkgDir, 0o700))
+
+		inFilename := filepath.Join(pkgDir, "options.go")
+		require.NoError(t, os.WriteFile(inFilename, []byte("package pkg // base\n"), 0o600))
+
+		for _, args := range [][]string{
+			{"init", "-q"},
+			{"add", "-A"},
+			{"-c", "user.name=qa", "-c", "user.email=qa@example.com", "commit", "-q", "-m", "base"},
+		} {
+			_, err := git(repo, args...)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, os.WriteFile(inFilename, []byte("package pkg // current\n"), 0o600))
+
+		filename, cleanup, err := resolveBaseFile("HEAD", inFilename)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		assert.Equal(t, "package pkg // base\n", string(content))
+
+		cleanup()
+		assert.NoFileExists(t, filename)
+
+		_, _, err = resolveBaseFile("unknown-revision", inFilename)
+		require.ErrorContains(t, err, "base is neither a directory nor a git revision")
+
+		// NOTE: the base is not passed to git worktree as an option.
+		_, _, err = resolveBaseFile("--force", inFilename)
+		require.ErrorContains(t, err, "base is neither a directory nor a git revision")
+
+		worktrees, err := git(repo, "worktree", "list", "--porcelain")
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(worktrees, "worktree "))
+	})
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+)
+
+// runFix runs the fix subcommand and returns the exit code.
+func runFix(args []string) int {
+	flags := flag.NewFlagSet("fix", flag.ContinueOnError)
+
+	var (
+		inFilename        string
+		optionsStructName string
+		unexport          bool
+	)
+
+	flags.StringVar(&inFilename,
+		"filename", os.Getenv("GOFILE"),
+		"input filename")
+	flags.StringVar(&optionsStructName,
+		"from-struct", "",
+		"struct that contains options")
+	flags.BoolVar(&unexport,
+		"unexport", false,
+		"unexport public fields and update references to them within the package")
+
+	if err := flags.Parse(args); err != nil {
+		return 2 //nolint:mnd
+	}
+
+	if isEmpty(inFilename, optionsStructName) {
+		flags.Usage()
+		//nolint:forbidigo
+		fmt.Println("missed required options")
+
+		return 2 //nolint:mnd
+	}
+
+	res, err := optionsgen.Fix(inFilename, optionsStructName, unexport)
+	for _, warning := range res.Warnings {
+		//nolint:forbidigo
+		fmt.Println(warning)
+	}
+
+	for _, filename := range res.Files {
+		//nolint:forbidigo
+		fmt.Println("fixed", filename)
+	}
+
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println(err.Error())
+
+		return 1
+	}
+
+	return 0
+}
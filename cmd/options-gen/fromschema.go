@@ -0,0 +1,144 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kazhuravlev/options-gen/internal/version"
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+)
+
+func runFromSchema(args []string) int {
+	flags := flag.NewFlagSet("from-schema", flag.ContinueOnError)
+
+	var (
+		outFilename           string
+		outPackageName        string
+		outPrefix             string
+		defaultsFrom          string
+		muteWarnings          bool
+		withIsset             bool
+		constructorTypeRender string
+		outSetterName         string
+		withStringer          bool
+		check                 bool
+		naming                string
+		initialisms           string
+		setterPrefix          string
+		style                 string
+		withTests             bool
+	)
+
+	flags.StringVar(&outFilename,
+		"out-filename", "",
+		"output filename. If not specified, [schema]_generated.go is used")
+	flags.StringVar(&outPackageName,
+		"pkg", os.Getenv("GOPACKAGE"),
+		"output package name")
+	flags.StringVar(&outPrefix,
+		"out-prefix", "",
+		"prefix for generated structs and functions")
+	flags.StringVar(&defaultsFrom,
+		"defaults-from", "tag=default",
+		"where to get defaults for options. none, tag=TagName, file=FileName")
+	flags.BoolVar(&muteWarnings,
+		"mute-warnings", false,
+		"mute all warnings")
+	flags.BoolVar(&withIsset,
+		"with-isset", false,
+		"generate a function that helps check which fields have been set")
+	flags.StringVar(&constructorTypeRender,
+		"constructor", string(optionsgen.ConstructorPublicRender),
+		"generate a function constructor. Possible values: public, private, no.")
+	flags.StringVar(&outSetterName,
+		"out-setter-name", "",
+		"name for the option setter type (function alias)")
+	flags.BoolVar(&withStringer,
+		"with-stringer", false,
+		"generate String and LogValue methods, which mask secret options")
+	flags.BoolVar(&check,
+		"check", false,
+		"do not write files, but check that generated files are up to date")
+	flags.StringVar(&naming,
+		"naming", string(optionsgen.NamingTitle),
+		"naming strategy for setters. Possible values: title, go.")
+	flags.StringVar(&initialisms,
+		"initialisms", "",
+		"list of project-specific initialisms for the go naming strategy, comma-separated")
+	flags.StringVar(&setterPrefix,
+		"setter-prefix", "With",
+		"prefix for setter names. Can be empty")
+	flags.StringVar(&style,
+		"style", string(optionsgen.StyleFunctional),
+		"style of the generated API. Possible values: functional, builder.")
+	flags.BoolVar(&withTests,
+		"with-tests", false,
+		"generate tests for defaults, setters and validation into [out-filename]_test.go")
+
+	if err := flags.Parse(args); err != nil {
+		return 2 //nolint:mnd
+	}
+
+	schemaFilename := flags.Arg(0)
+	if flags.NArg() != 1 || isEmpty(schemaFilename, outPackageName) {
+		flags.Usage()
+		//nolint:forbidigo
+		fmt.Println("usage: options-gen from-schema [flags] schema.yaml")
+
+		return 2 //nolint:mnd
+	}
+
+	if outFilename == "" {
+		outFilename = strings.TrimSuffix(schemaFilename, filepath.Ext(schemaFilename)) + "_generated.go"
+	}
+
+	defaults, err := parseDefaults(defaultsFrom)
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println("bad defaults spec", err.Error())
+
+		return 2 //nolint:mnd
+	}
+
+	spec, err := optionsgen.LoadSchema(schemaFilename)
+	if err != nil {
+		//nolint:forbidigo
+		fmt.Println(err.Error())
+
+		return 1
+	}
+
+	errRun := optionsgen.Run(
+		optionsgen.NewOptions(
+			optionsgen.WithVersion(version.GetVersion()),
+			optionsgen.WithInFilename(schemaFilename),
+			optionsgen.WithOutFilename(outFilename),
+			optionsgen.WithPackageName(outPackageName),
+			optionsgen.WithOptionSpec(spec),
+			optionsgen.WithOutPrefix(outPrefix),
+			optionsgen.WithDefaults(*defaults),
+			optionsgen.WithShowWarnings(!muteWarnings),
+			optionsgen.WithWithIsset(withIsset),
+			optionsgen.WithConstructorTypeRender(optionsgen.ConstructorTypeRender(constructorTypeRender)),
+			optionsgen.WithOutOptionTypeName(outSetterName),
+			optionsgen.WithWithStringer(withStringer),
+			optionsgen.WithCheck(check),
+			optionsgen.WithNaming(optionsgen.Naming(naming)),
+			optionsgen.WithInitialisms(splitInitialisms(initialisms)...),
+			optionsgen.WithSetterPrefix(setterPrefix),
+			optionsgen.WithStyle(optionsgen.Style(style)),
+			optionsgen.WithWithTests(withTests),
+		),
+	)
+	if errRun != nil {
+		//nolint:forbidigo
+		fmt.Println("cannot run options gen", errRun.Error())
+
+		return 1
+	}
+
+	return 0
+}
@@ -14,6 +14,19 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "apidiff":
+			os.Exit(runAPIDiff(os.Args[2:]))
+		case "fix":
+			os.Exit(runFix(os.Args[2:]))
+		case "adopt":
+			os.Exit(runAdopt(os.Args[2:]))
+		case "from-schema":
+			os.Exit(runFromSchema(os.Args[2:]))
+		}
+	}
+
 	var (
 		inFilename            string
 		outFilename           string
@@ -27,6 +40,25 @@ func main() {
 		constructorTypeRender optionsgen.ConstructorTypeRender
 		outSetterName         string
 		exclude               string
+		envPrefix             string
+		withFlags             bool
+		withDecode            bool
+		decodeStrict          bool
+		withStringer          bool
+		withSpec              bool
+		withSource            bool
+		docOut                string
+		docFormat             string
+		check                 bool
+		jsonSchemaOut         string
+		naming                string
+		initialisms           string
+		setterPrefix          string
+		constructorName       string
+		withCombinators       bool
+		style                 string
+		withTests             bool
+		fromFunc              string
 	)
 
 	envGoFile := os.Getenv("GOFILE")
@@ -45,10 +77,13 @@ func main() {
 		"output filename")
 	flag.StringVar(&optionsStructName,
 		"from-struct", "",
-		"struct that contains options")
+		"struct that contains options. With -from-func it is the name of the generated params struct")
+	flag.StringVar(&fromFunc,
+		"from-func", "",
+		"function marked with //options-gen:func, which parameters are options")
 	flag.StringVar(&defaultsFrom,
 		"defaults-from", "tag=default",
-		"where to get defaults for options. none, tag=TagName, func=FuncName, var=VarName")
+		"where to get defaults for options. none, tag=TagName, func=FuncName, var=VarName, file=FileName")
 	flag.BoolVar(&muteWarnings,
 		"mute-warnings", false,
 		"mute all warnings")
@@ -73,9 +108,72 @@ func main() {
 		"out-setter-name", "",
 		"name for the option setter type (function alias). If not specified, the 'Opt[StructName]Setter' template is used.")
 	flag.StringVar(&exclude, "exclude", "", "list of masks for field names excluded from generation, semicolon-separated")
+	flag.StringVar(&envPrefix,
+		"env-prefix", "",
+		"prefix for environment variable names which are set by env tag")
+	flag.BoolVar(&withFlags,
+		"with-flags", false,
+		"generate a function that registers options in flag.FlagSet")
+	flag.BoolVar(&withDecode,
+		"with-decode", false,
+		"generate functions that decode options from map and JSON")
+	flag.BoolVar(&decodeStrict,
+		"decode-strict", false,
+		"reject unknown keys while decoding options")
+	flag.BoolVar(&withStringer,
+		"with-stringer", false,
+		"generate String and LogValue methods, which mask secret options")
+	flag.BoolVar(&withSpec,
+		"with-spec", false,
+		"generate <StructName>Spec function and SpecValues method that describe options at runtime")
+	flag.BoolVar(&withSource,
+		"with-source", false,
+		"generate Source method that reports where the value of each option came from")
+	flag.StringVar(&docOut,
+		"doc-out", "",
+		"output filename for the documentation of options. Documentation is not generated when empty")
+	flag.StringVar(&docFormat,
+		"doc-format", string(optionsgen.DocFormatMarkdown),
+		"format of the documentation. Possible values: "+strings.Join([]string{
+			string(optionsgen.DocFormatMarkdown),
+			string(optionsgen.DocFormatHTML),
+		}, ", ")+".")
+	flag.BoolVar(&check,
+		"check", false,
+		"do not write files, but check that generated files are up to date")
+	flag.StringVar(&jsonSchemaOut,
+		"json-schema-out", "",
+		"output filename for the JSON Schema of options. Schema is not generated when empty")
+	flag.StringVar(&naming,
+		"naming", string(optionsgen.NamingTitle),
+		"naming strategy for setters. Possible values: "+strings.Join([]string{
+			string(optionsgen.NamingTitle),
+			string(optionsgen.NamingGo),
+		}, ", ")+". The go strategy upper-cases common initialisms: WithHTTPClient instead of WithHttpClient.")
+	flag.StringVar(&initialisms,
+		"initialisms", "",
+		"list of project-specific initialisms for the go naming strategy, comma-separated")
+	flag.StringVar(&setterPrefix,
+		"setter-prefix", "With",
+		"prefix for setter names. Can be empty")
+	flag.StringVar(&constructorName,
+		"constructor-name", "",
+		"name of the constructor. If not specified, New[StructName] or new[StructName] is used")
+	flag.BoolVar(&withCombinators,
+		"with-combinators", false,
+		"generate If and Chain combinators and setters for variables annotated with //options-gen:preset")
+	flag.StringVar(&style,
+		"style", string(optionsgen.StyleFunctional),
+		"style of the generated API. Possible values: "+strings.Join([]string{
+			string(optionsgen.StyleFunctional),
+			string(optionsgen.StyleBuilder),
+		}, ", ")+".")
+	flag.BoolVar(&withTests,
+		"with-tests", false,
+		"generate tests for defaults, setters and validation into [out-filename]_test.go")
 	flag.Parse()
 
-	if isEmpty(inFilename, outFilename, outPackageName, optionsStructName, defaultsFrom) {
+	if isEmpty(inFilename, outFilename, outPackageName, defaultsFrom) || (optionsStructName == "" && fromFunc == "") {
 		flag.Usage()
 		//nolint:forbidigo
 		fmt.Println("missed required options")
@@ -114,6 +212,25 @@ func main() {
 			optionsgen.WithConstructorTypeRender(constructorTypeRender),
 			optionsgen.WithOutOptionTypeName(outSetterName),
 			optionsgen.WithExclude(excludes...),
+			optionsgen.WithEnvPrefix(envPrefix),
+			optionsgen.WithWithFlags(withFlags),
+			optionsgen.WithWithDecode(withDecode),
+			optionsgen.WithDecodeStrict(decodeStrict),
+			optionsgen.WithWithStringer(withStringer),
+			optionsgen.WithWithSpec(withSpec),
+			optionsgen.WithWithSource(withSource),
+			optionsgen.WithDocOut(docOut),
+			optionsgen.WithDocFormat(optionsgen.DocFormat(docFormat)),
+			optionsgen.WithCheck(check),
+			optionsgen.WithJSONSchemaOut(jsonSchemaOut),
+			optionsgen.WithNaming(optionsgen.Naming(naming)),
+			optionsgen.WithInitialisms(splitInitialisms(initialisms)...),
+			optionsgen.WithSetterPrefix(setterPrefix),
+			optionsgen.WithConstructorName(constructorName),
+			optionsgen.WithWithCombinators(withCombinators),
+			optionsgen.WithStyle(optionsgen.Style(style)),
+			optionsgen.WithWithTests(withTests),
+			optionsgen.WithFromFunc(fromFunc),
 		),
 	)
 	if errRun != nil {
@@ -150,6 +267,11 @@ func parseDefaults(in string) (*optionsgen.Defaults, error) {
 			From:  from,
 			Param: get1(parts),
 		}, nil
+	case optionsgen.DefaultsFromFile:
+		return &optionsgen.Defaults{
+			From:  from,
+			Param: get1(parts),
+		}, nil
 	}
 
 	return nil, errors.New("bad syntax")
@@ -192,3 +314,14 @@ func splitExcludes(exclude string) ([]*regexp.Regexp, error) {
 
 	return result, nil
 }
+
+func splitInitialisms(initialisms string) []string {
+	var res []string
+	for _, initialism := range strings.Split(initialisms, ",") {
+		if initialism = strings.TrimSpace(initialism); initialism != "" {
+			res = append(res, initialism)
+		}
+	}
+
+	return res
+}
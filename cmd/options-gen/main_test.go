@@ -89,6 +89,15 @@ func Test_parseDefaults(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:  "file with parameter",
+			input: "file=defaults.yaml",
+			want: &optionsgen.Defaults{
+				From:  optionsgen.DefaultsFromFile,
+				Param: "defaults.yaml",
+			},
+			wantErr: false,
+		},
 		{
 			name:    "invalid source",
 			input:   "invalid",
@@ -422,3 +431,25 @@ func Test_splitExcludes_ErrorMessages(t *testing.T) {
 		assert.Contains(t, err.Error(), "compile")
 	})
 }
+
+func Test_splitInitialisms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "empty string", input: "", want: nil},
+		{name: "single", input: "GRPC", want: []string{"GRPC"}},
+		{name: "multiple with spaces", input: "grpc, k8s ,,AWS", want: []string{"grpc", "k8s", "AWS"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, splitInitialisms(tt.input))
+		})
+	}
+}
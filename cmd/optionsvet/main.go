@@ -0,0 +1,13 @@
+// Command optionsvet reports misuse of the options generated by options-gen:
+//
+//	go vet -vettool=$(which optionsvet) ./...
+package main
+
+import (
+	"github.com/kazhuravlev/options-gen/pkg/optionsvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(optionsvet.Analyzer)
+}
@@ -0,0 +1,564 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// AdoptedSetter is a hand-written setter, which is replaced by the generated
+// one.
+type AdoptedSetter struct {
+	Name  string
+	Field string
+}
+
+type AdoptRes struct {
+	Files    []FixedFile
+	Setters  []AdoptedSetter
+	Warnings []string
+}
+
+// handSetter is a hand-written functional option like
+//
+//	func WithX(v T) Option { return func(o *options) { o.x = v } }
+type handSetter struct {
+	decl     *ast.FuncDecl
+	filename string
+	name     string
+	field    string
+	typ      string
+	result   string
+	variadic bool
+}
+
+// Adopt finds hand-written setters of the options struct in the package of
+// filePath and replaces them with generated ones: tags of fields get `name`,
+// `alias` and `variadic` options, and setters, which generation with opts
+// reproduces, are removed together with the hand-written option type.
+// Only changed files are returned; comments and formatting are kept.
+func Adopt(filePath string, opts Options) (*AdoptRes, error) {
+	if opts.style != StyleFunctional {
+		return nil, fmt.Errorf("only the %s style can be adopted", StyleFunctional)
+	}
+
+	filePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get absolute path: %w", err)
+	}
+
+	fset, files, err := adoptPackageFiles(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	structName := opts.optionsStructName
+
+	fields, err := findFixStruct(files[filePath], structName)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldByName := make(map[string]*ast.Field, len(fields))
+	for _, field := range fields {
+		for _, name := range field.Names {
+			fieldByName[name.Name] = field
+		}
+	}
+
+	res := new(AdoptRes)
+	warn := func(format string, args ...any) {
+		res.Warnings = append(res.Warnings, "Warning: "+fmt.Sprintf(format, args...))
+	}
+
+	setters := make(map[*ast.Field][]handSetter)
+	otherResults := make(map[string]struct{})
+	for _, setter := range findHandSetters(files, structName) {
+		field, ok := fieldByName[setter.field]
+		switch {
+		case !ok:
+			warn("setter `%s` is not adopted: `%s` is not an option", setter.name, setter.field)
+		case len(field.Names) != 1:
+			warn("setter `%s` is not adopted: `%s` is declared together with other fields", setter.name, setter.field)
+		case setter.typ != types.ExprString(field.Type):
+			warn("setter `%s` is not adopted: it takes %s, but `%s` is %s",
+				setter.name, setter.typ, setter.field, types.ExprString(field.Type))
+		case setter.result != opts.optionTypeName:
+			otherResults[setter.result] = struct{}{}
+		default:
+			setters[field] = append(setters[field], setter)
+		}
+	}
+
+	for _, result := range slices.Sorted(maps.Keys(otherResults)) {
+		warn("setters returning `%s` are not adopted: generated setters return `%s`, consider -out-setter-name=%s",
+			result, opts.optionTypeName, result)
+	}
+
+	edits := make(map[string][]fixEdit)
+	for _, field := range fields {
+		if len(setters[field]) == 0 {
+			continue
+		}
+
+		adopted, tag, warnings := adoptField(opts, field, setters[field])
+		res.Warnings = append(res.Warnings, warnings...)
+
+		if len(adopted) == 0 {
+			continue
+		}
+
+		if edit, ok := setterDocEdit(fset, field, adopted, warn); ok {
+			edits[filePath] = append(edits[filePath], edit)
+		}
+
+		if tag != "" {
+			edit := fixEdit{offset: fset.Position(field.Type.End()).Offset, length: 0, text: " " + tag}
+			if field.Tag != nil {
+				edit = fixEdit{offset: fset.Position(field.Tag.Pos()).Offset, length: len(field.Tag.Value), text: tag}
+			}
+
+			edits[filePath] = append(edits[filePath], edit)
+		}
+
+		for _, setter := range adopted {
+			edits[setter.filename] = append(edits[setter.filename], removeDeclEdit(fset, setter.decl, setter.decl.Doc))
+			res.Setters = append(res.Setters, AdoptedSetter{Name: setter.name, Field: setter.field})
+		}
+	}
+
+	if len(res.Setters) != 0 {
+		for filename, file := range files {
+			for _, edit := range optionTypeDeclEdits(fset, file, opts.optionTypeName, structName, warn) {
+				edits[filename] = append(edits[filename], edit)
+			}
+		}
+	}
+
+	for filename, fileEdits := range edits {
+		content, err := applyFixEdits(filename, fileEdits)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err = pruneStaleImports(files[filename], content)
+		if err != nil {
+			return nil, fmt.Errorf("cannot prune imports of %s: %w", filename, err)
+		}
+
+		res.Files = append(res.Files, FixedFile{Filename: filename, Content: content})
+	}
+
+	slices.SortFunc(res.Files, func(a, b FixedFile) int { return strings.Compare(a.Filename, b.Filename) })
+
+	return res, nil
+}
+
+// adoptPackageFiles parses non-test files of the package of filePath by
+// filename, except generated ones.
+func adoptPackageFiles(filePath string) (*token.FileSet, map[string]*ast.File, error) {
+	fset, files, err := parsePackageFiles(filePath, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pkgName string
+	for _, file := range files {
+		if fset.Position(file.Pos()).Filename == filePath {
+			pkgName = file.Name.Name
+		}
+	}
+
+	res := make(map[string]*ast.File, len(files))
+	for _, file := range files {
+		if file.Name.Name == pkgName && !ast.IsGenerated(file) {
+			res[fset.Position(file.Pos()).Filename] = file
+		}
+	}
+
+	if _, ok := res[filePath]; !ok {
+		return nil, nil, fmt.Errorf("source file not exist or is generated: %s", filePath)
+	}
+
+	return fset, res, nil
+}
+
+// findHandSetters returns hand-written setters of the struct in the order of
+// declaration.
+func findHandSetters(files map[string]*ast.File, structName string) []handSetter {
+	var res []handSetter
+	for _, filename := range slices.Sorted(maps.Keys(files)) {
+		for _, decl := range files[filename].Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			if setter, ok := matchHandSetter(funcDecl, structName); ok {
+				setter.filename = filename
+				res = append(res, setter)
+			}
+		}
+	}
+
+	return res
+}
+
+func matchHandSetter(decl *ast.FuncDecl, structName string) (handSetter, bool) {
+	var setter handSetter
+
+	funcType := decl.Type
+	if decl.Recv != nil || decl.Body == nil || funcType.TypeParams != nil ||
+		funcType.Results == nil || len(funcType.Results.List) != 1 ||
+		len(funcType.Params.List) != 1 || len(funcType.Params.List[0].Names) != 1 ||
+		len(decl.Body.List) != 1 {
+		return setter, false
+	}
+
+	param := funcType.Params.List[0]
+	paramName := param.Names[0].Name
+
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return setter, false
+	}
+
+	lit, ok := ret.Results[0].(*ast.FuncLit)
+	if !ok || lit.Type.Results != nil || len(lit.Type.Params.List) != 1 ||
+		len(lit.Type.Params.List[0].Names) != 1 || len(lit.Body.List) != 1 {
+		return setter, false
+	}
+
+	star, ok := lit.Type.Params.List[0].Type.(*ast.StarExpr)
+	if !ok || !isIdent(star.X, structName) {
+		return setter, false
+	}
+
+	assign, ok := lit.Body.List[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return setter, false
+	}
+
+	target := lit.Type.Params.List[0].Names[0].Name
+
+	field, ok := selectedField(assign.Lhs[0], target)
+	if !ok {
+		return setter, false
+	}
+
+	setter = handSetter{
+		decl:   decl,
+		name:   decl.Name.Name,
+		field:  field,
+		typ:    types.ExprString(param.Type),
+		result: types.ExprString(funcType.Results.List[0].Type),
+	}
+
+	if ellipsis, ok := param.Type.(*ast.Ellipsis); ok {
+		// NOTE: variadic setters append values: o.x = append(o.x, v...).
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok || !isIdent(call.Fun, "append") || !call.Ellipsis.IsValid() || len(call.Args) != 2 ||
+			!isIdent(call.Args[1], paramName) {
+			return setter, false
+		}
+
+		if appendTo, ok := selectedField(call.Args[0], target); !ok || appendTo != field {
+			return setter, false
+		}
+
+		setter.typ = "[]" + types.ExprString(ellipsis.Elt)
+		setter.variadic = true
+
+		return setter, true
+	}
+
+	return setter, isIdent(assign.Rhs[0], paramName)
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == name
+}
+
+// selectedField returns the field name of the target.field expression.
+func selectedField(expr ast.Expr, target string) (string, bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || !isIdent(sel.X, target) {
+		return "", false
+	}
+
+	return sel.Sel.Name, true
+}
+
+// adoptField returns setters of the field, which are reproduced by
+// generation, and the new tag of the field. The tag is empty when it is not
+// changed.
+func adoptField(opts Options, field *ast.Field, setters []handSetter) ([]handSetter, string, []string) {
+	fieldName := field.Names[0].Name
+
+	var warnings []string
+	notAdopted := func(setter handSetter, reason string) {
+		warnings = append(warnings, fmt.Sprintf("Warning: setter `%s` is not adopted: %s", setter.name, reason))
+	}
+
+	tagOption, _ := parseTag(field.Tag, fieldName, "")
+	if tagOption.Skip {
+		for _, setter := range setters {
+			notAdopted(setter, fmt.Sprintf("`%s` is skipped by the tag", fieldName))
+		}
+
+		return nil, "", warnings
+	}
+
+	generated := func() templateOptionMeta {
+		meta := OptionMeta{
+			Name:      cases.Title(language.English, cases.NoLower).String(fieldName),
+			Field:     fieldName,
+			Type:      types.ExprString(field.Type),
+			TagOption: tagOption,
+		}
+
+		genOpts := opts
+		genOpts.spec = &OptionSpec{Options: []OptionMeta{meta}}
+
+		return makeTemplateOptions(genOpts)[0]
+	}
+
+	cur := generated()
+	if !cur.HasSetter() {
+		for _, setter := range setters {
+			notAdopted(setter, fmt.Sprintf("`%s` has no setter of its own", fieldName))
+		}
+
+		return nil, "", warnings
+	}
+
+	variadic := setters[0].variadic
+	if tagOption.VariadicIsSet && tagOption.Variadic != variadic {
+		for _, setter := range setters {
+			notAdopted(setter, fmt.Sprintf("variadic of `%s` is %t", fieldName, tagOption.Variadic))
+		}
+
+		return nil, "", warnings
+	}
+
+	var newOptions []string
+	if variadic && !tagOption.Variadic {
+		tagOption.Variadic, tagOption.VariadicIsSet = true, true
+		newOptions = append(newOptions, "variadic=true")
+	}
+
+	primaryTaken := tagOption.Name != "" || slices.ContainsFunc(setters, func(s handSetter) bool {
+		return s.name == cur.SetterName
+	})
+
+	prefix := opts.setterPrefix + opts.prefix
+	for _, setter := range setters {
+		name, hasPrefix := strings.CutPrefix(setter.name, prefix)
+		switch {
+		case setter.variadic != variadic,
+			setter.name == cur.SetterName,
+			slices.Contains(cur.AliasNames, setter.name):
+			// NOTE: nothing to add, the check below reports a mismatch.
+		case !hasPrefix || !setterNamePattern.MatchString(name):
+			notAdopted(setter, fmt.Sprintf("generated setters are named %s...", prefix))
+		case !primaryTaken:
+			primaryTaken = true
+			tagOption.Name = name
+			newOptions = append(newOptions, "name="+name)
+		default:
+			tagOption.Aliases = append(tagOption.Aliases, name)
+			newOptions = append(newOptions, "alias="+name)
+		}
+	}
+
+	// NOTE: a setter is removed only when generation reproduces it.
+	cur = generated()
+
+	var adopted []handSetter
+	for _, setter := range setters {
+		reproduced := setter.name == cur.SetterName || slices.Contains(cur.AliasNames, setter.name)
+		switch {
+		case setter.variadic != cur.TagOption.Variadic:
+			notAdopted(setter, fmt.Sprintf("variadic of `%s` is %t", fieldName, cur.TagOption.Variadic))
+		case reproduced:
+			adopted = append(adopted, setter)
+		}
+	}
+
+	if len(newOptions) == 0 || len(adopted) == 0 {
+		return adopted, "", warnings
+	}
+
+	tag, err := addTagOptions(field.Tag, newOptions)
+	if err != nil {
+		for _, setter := range adopted {
+			notAdopted(setter, err.Error())
+		}
+
+		return nil, "", warnings
+	}
+
+	return adopted, tag, warnings
+}
+
+// addTagOptions appends options to the `option` key of the tag.
+func addTagOptions(tag *ast.BasicLit, options []string) (string, error) {
+	var pairs []structTagPair
+	if tag != nil {
+		raw, err := strconv.Unquote(tag.Value)
+		if err != nil {
+			return "", fmt.Errorf("cannot parse tag %s", tag.Value)
+		}
+
+		var ok bool
+		if pairs, ok = parseStructTag(raw); !ok {
+			return "", fmt.Errorf("cannot parse tag %s", tag.Value)
+		}
+	}
+
+	idx := slices.IndexFunc(pairs, func(p structTagPair) bool { return p.key == "option" })
+	if idx < 0 {
+		pairs = append(pairs, structTagPair{key: "option", value: ""})
+		idx = len(pairs) - 1
+	}
+
+	pairs[idx].value = strings.TrimPrefix(pairs[idx].value+","+strings.Join(options, ","), ",")
+
+	return renderStructTag(pairs), nil
+}
+
+// setterDocEdit moves the doc comment of the first documented setter onto the
+// field without a doc comment: the field doc is the doc of generated setters.
+// Other doc comments of setters are dropped with a warning.
+func setterDocEdit(
+	fset *token.FileSet,
+	field *ast.Field,
+	setters []handSetter,
+	warn func(format string, args ...any),
+) (fixEdit, bool) {
+	var (
+		edit  fixEdit
+		moved bool
+	)
+
+	for _, setter := range setters {
+		doc := setter.decl.Doc
+		switch {
+		case doc == nil:
+		case field.Doc == nil && !moved:
+			lines := make([]string, len(doc.List))
+			for i, comment := range doc.List {
+				lines[i] = comment.Text
+			}
+
+			edit = fixEdit{offset: fset.Position(field.Pos()).Offset, length: 0, text: strings.Join(lines, "\n") + "\n"}
+			moved = true
+		default:
+			warn("doc comment of setter `%s` is dropped: move it to `%s` manually", setter.name, setter.field)
+		}
+	}
+
+	return edit, moved
+}
+
+// removeDeclEdit removes the node with its doc comment and the line break.
+func removeDeclEdit(fset *token.FileSet, node ast.Node, doc *ast.CommentGroup) fixEdit {
+	start := node.Pos()
+	if doc != nil {
+		start = doc.Pos()
+	}
+
+	offset := fset.Position(start).Offset
+	end := fset.Position(node.End()).Offset
+	if end < fset.File(start).Size() {
+		end++
+	}
+
+	return fixEdit{offset: offset, length: end - offset, text: ""}
+}
+
+// optionTypeDeclEdits removes the hand-written option type, which is
+// declared by generated code. Its doc comment is dropped with a warning.
+func optionTypeDeclEdits(
+	fset *token.FileSet,
+	file *ast.File,
+	typeName, structName string,
+	warn func(format string, args ...any),
+) []fixEdit {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec) //nolint:forcetypeassert
+			if typeSpec.Name.Name != typeName || typeSpec.TypeParams != nil || typeSpec.Assign.IsValid() {
+				continue
+			}
+
+			funcType, ok := typeSpec.Type.(*ast.FuncType)
+			if !ok || funcType.Results != nil || len(funcType.Params.List) != 1 ||
+				len(funcType.Params.List[0].Names) > 1 {
+				return nil
+			}
+
+			if star, ok := funcType.Params.List[0].Type.(*ast.StarExpr); !ok || !isIdent(star.X, structName) {
+				return nil
+			}
+
+			var node ast.Node = genDecl
+			doc := genDecl.Doc
+			if genDecl.Lparen.IsValid() && len(genDecl.Specs) > 1 {
+				node, doc = typeSpec, typeSpec.Doc
+			}
+
+			if doc != nil {
+				warn("doc comment of `%s` is dropped: the type is declared by generated code", typeName)
+			}
+
+			return []fixEdit{removeDeclEdit(fset, node, doc)}
+		}
+	}
+
+	return nil
+}
+
+// pruneStaleImports removes imports, which were used by the original file and
+// are not used anymore.
+func pruneStaleImports(original *ast.File, content []byte) ([]byte, error) {
+	usedBefore := usedSelectorNames(original)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parse source: %w", err)
+	}
+
+	pruneImports(fset, file, func(name string) bool {
+		_, ok := usedBefore[name]
+
+		return ok
+	})
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("format source: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
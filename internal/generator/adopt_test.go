@@ -0,0 +1,221 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdopt(t *testing.T) {
+	t.Parallel()
+
+	const options = `package p
+
+import "time"
+
+// Option configures Options.
+type Option func(*Options)
+
+type Options struct {
+	addr    string ` + "`option:\"mandatory\"`" + `
+	timeout time.Duration
+	// tags of requests.
+	tags    []string
+	retries int ` + "`default:\"3\"`" + `
+	debug   bool
+}
+`
+
+	const setters = `package p
+
+import (
+	"strings"
+	"time"
+)
+
+// WithTimeout sets the timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(o *Options) { o.timeout = d }
+}
+
+// WithTags adds tags.
+func WithTags(tags ...string) Option {
+	return func(o *Options) { o.tags = append(o.tags, tags...) }
+}
+
+func WithTag(tags ...string) Option {
+	return func(o *Options) { o.tags = append(o.tags, tags...) }
+}
+
+func WithMaxRetries(n int) Option {
+	return func(o *Options) { o.retries = n }
+}
+
+func WithAddr(addr string) Option {
+	return func(o *Options) { o.addr = addr }
+}
+
+func WithDebug(s string) Option {
+	return func(o *Options) { o.debug = strings.EqualFold(s, "true") }
+}
+
+func WithTimeoutSeconds(n int) Option {
+	return func(o *Options) { o.retries = n }
+}
+
+func WithRetries(n int64) Option {
+	return func(o *Options) { o.retries = n }
+}
+`
+
+	setup := func(t *testing.T) string {
+		t.Helper()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "options.go"), []byte(options), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "setters.go"), []byte(setters), 0o600))
+
+		return dir
+	}
+
+	t.Run("adopt", func(t *testing.T) {
+		t.Parallel()
+
+		dir := setup(t)
+		res, err := Adopt(filepath.Join(dir, "options.go"), NewOptions(
+			WithOptionsStructName("Options"),
+			WithOptionTypeName("Option"),
+		))
+		require.NoError(t, err)
+
+		assert.Equal(t, []AdoptedSetter{
+			{Name: "WithTimeout", Field: "timeout"},
+			{Name: "WithTags", Field: "tags"},
+			{Name: "WithTag", Field: "tags"},
+			{Name: "WithMaxRetries", Field: "retries"},
+			{Name: "WithTimeoutSeconds", Field: "retries"},
+		}, res.Setters)
+		assert.Equal(t, []string{
+			"Warning: setter `WithRetries` is not adopted: it takes int64, but `retries` is int",
+			"Warning: setter `WithAddr` is not adopted: `addr` has no setter of its own",
+			"Warning: doc comment of setter `WithTags` is dropped: move it to `tags` manually",
+			"Warning: doc comment of `Option` is dropped: the type is declared by generated code",
+		}, res.Warnings)
+
+		require.Len(t, res.Files, 2)
+		assert.Equal(t, filepath.Join(dir, "options.go"), res.Files[0].Filename)
+		assert.Equal(t, `package p
+
+import "time"
+
+type Options struct {
+	addr string `+"`option:\"mandatory\"`"+`
+	// WithTimeout sets the timeout.
+	timeout time.Duration
+	// tags of requests.
+	tags    []string `+"`option:\"variadic=true,alias=Tag\"`"+`
+	retries int      `+"`default:\"3\" option:\"name=MaxRetries,alias=TimeoutSeconds\"`"+`
+	debug   bool
+}
+`, string(res.Files[0].Content))
+
+		assert.Equal(t, filepath.Join(dir, "setters.go"), res.Files[1].Filename)
+		assert.Equal(t, `package p
+
+import (
+	"strings"
+)
+
+func WithAddr(addr string) Option {
+	return func(o *Options) { o.addr = addr }
+}
+
+func WithDebug(s string) Option {
+	return func(o *Options) { o.debug = strings.EqualFold(s, "true") }
+}
+
+func WithRetries(n int64) Option {
+	return func(o *Options) { o.retries = n }
+}
+`, string(res.Files[1].Content))
+	})
+
+	t.Run("option_type_differs", func(t *testing.T) {
+		t.Parallel()
+
+		dir := setup(t)
+		res, err := Adopt(filepath.Join(dir, "options.go"), NewOptions(
+			WithOptionsStructName("Options"),
+			WithOptionTypeName("OptOptionsSetter"),
+		))
+		require.NoError(t, err)
+		assert.Empty(t, res.Setters)
+		assert.Empty(t, res.Files)
+		assert.Equal(t, []string{
+			"Warning: setter `WithRetries` is not adopted: it takes int64, but `retries` is int",
+			"Warning: setters returning `Option` are not adopted: generated setters return `OptOptionsSetter`, " +
+				"consider -out-setter-name=Option",
+		}, res.Warnings)
+	})
+
+	t.Run("builder_style", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Adopt(filepath.Join(setup(t), "options.go"), NewOptions(
+			WithOptionsStructName("Options"),
+			WithOptionTypeName("Option"),
+			WithStyle(StyleBuilder),
+		))
+		require.EqualError(t, err, "only the functional style can be adopted")
+	})
+}
+
+func TestMatchHandSetter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+		want *handSetter
+	}{
+		{"plain", "func WithA(v int) Option { return func(o *Options) { o.a = v } }",
+			&handSetter{name: "WithA", field: "a", typ: "int", result: "Option"}},
+		{"variadic", "func WithA(v ...int) Option { return func(o *Options) { o.a = append(o.a, v...) } }",
+			&handSetter{name: "WithA", field: "a", typ: "[]int", result: "Option", variadic: true}},
+		{"variadic_replaces", "func WithA(v ...int) Option { return func(o *Options) { o.a = v } }", nil},
+		{"appends_one", "func WithA(v int) Option { return func(o *Options) { o.a = append(o.a, v) } }", nil},
+		{"appends_to_other", "func WithA(v ...int) Option { return func(o *Options) { o.a = append(o.b, v...) } }", nil},
+		{"other_struct", "func WithA(v int) Option { return func(o *Other) { o.a = v } }", nil},
+		{"expression", "func WithA(v int) Option { return func(o *Options) { o.a = v + 1 } }", nil},
+		{"two_statements", "func WithA(v int) Option { return func(o *Options) { o.a = v; o.b = v } }", nil},
+		{"method", "func (x X) WithA(v int) Option { return func(o *Options) { o.a = v } }", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := parser.ParseFile(token.NewFileSet(), "p.go", "package p\n\n"+tt.src+"\n", 0)
+			require.NoError(t, err)
+
+			decl := file.Decls[0].(*ast.FuncDecl) //nolint:forcetypeassert
+			got, ok := matchHandSetter(decl, "Options")
+			if tt.want == nil {
+				assert.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			tt.want.decl = decl
+			assert.Equal(t, *tt.want, got)
+		})
+	}
+}
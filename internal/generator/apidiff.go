@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+type APIChangeKind string
+
+const (
+	// APIChangeCompatible does not affect existing users.
+	APIChangeCompatible APIChangeKind = "compatible"
+	// APIChangeBehavioural keeps the code compiling, but changes its behaviour.
+	APIChangeBehavioural APIChangeKind = "behavioural"
+	// APIChangeBreaking breaks the code of users.
+	APIChangeBreaking APIChangeKind = "breaking"
+)
+
+// APIChange is a change of the generated API between two revisions of the
+// options struct. Field is empty for changes of group setters and of the
+// constructor.
+type APIChange struct {
+	Kind    APIChangeKind
+	Field   string
+	Message string
+}
+
+// DiffAPI compares the generated API of two revisions of the options struct.
+// Both options must be configured the same way, only specs differ.
+func DiffAPI(base, current Options) []APIChange {
+	baseOptions := makeTemplateOptions(base)
+	currentOptions := makeTemplateOptions(current)
+
+	baseByField := make(map[string]templateOptionMeta, len(baseOptions))
+	for _, opt := range baseOptions {
+		baseByField[opt.Field] = opt
+	}
+
+	currentByField := make(map[string]templateOptionMeta, len(currentOptions))
+	for _, opt := range currentOptions {
+		currentByField[opt.Field] = opt
+	}
+
+	var changes []APIChange
+	add := func(kind APIChangeKind, field, format string, args ...any) {
+		changes = append(changes, APIChange{Kind: kind, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, cur := range currentOptions {
+		field := cur.Field
+
+		old, ok := baseByField[field]
+		if !ok {
+			if cur.TagOption.IsRequired {
+				add(APIChangeBreaking, field, "new mandatory parameter `%s` of the constructor", field)
+			} else {
+				add(APIChangeCompatible, field, "added optional field `%s` with setter %s", field, cur.PublicSetterName())
+			}
+
+			continue
+		}
+
+		switch {
+		case !old.TagOption.IsRequired && cur.TagOption.IsRequired:
+			add(APIChangeBreaking, field, "field `%s` became a mandatory parameter of the constructor", field)
+		case old.TagOption.IsRequired && !cur.TagOption.IsRequired:
+			add(APIChangeBreaking, field,
+				"field `%s` is not a parameter of the constructor anymore, use %s", field, cur.PublicSetterName())
+		default:
+			diffSetters(old, cur, add)
+		}
+
+		if old.Type != cur.Type {
+			add(APIChangeBreaking, field, "type of `%s` changed from %s to %s", field, old.Type, cur.Type)
+		}
+
+		if old.TagOption.Default != cur.TagOption.Default {
+			add(APIChangeBehavioural, field, "default of `%s` changed from %s to %s",
+				field, describeDefault(old.TagOption.Default), describeDefault(cur.TagOption.Default))
+		}
+	}
+
+	for _, old := range baseOptions {
+		if _, ok := currentByField[old.Field]; ok {
+			continue
+		}
+
+		if old.TagOption.IsRequired {
+			add(APIChangeBreaking, old.Field, "removed mandatory parameter `%s` of the constructor", old.Field)
+		} else {
+			add(APIChangeBreaking, old.Field, "removed field `%s` with setter %s", old.Field, old.PublicSetterName())
+		}
+	}
+
+	diffGroups(makeTemplateGroups(baseOptions), makeTemplateGroups(currentOptions), add)
+
+	if !slices.Equal(commonMandatory(baseOptions, currentByField), commonMandatory(currentOptions, baseByField)) {
+		add(APIChangeBreaking, "", "order of the constructor parameters changed")
+	}
+
+	return changes
+}
+
+// diffSetters compares own setters and aliases of the optional field.
+func diffSetters(old, cur templateOptionMeta, add func(APIChangeKind, string, string, ...any)) {
+	oldSetters, curSetters := ownSetters(old), ownSetters(cur)
+	for _, name := range oldSetters {
+		if !slices.Contains(curSetters, name) {
+			add(APIChangeBreaking, old.Field, "removed setter %s of `%s`", name, old.Field)
+		}
+	}
+
+	for _, name := range curSetters {
+		if !slices.Contains(oldSetters, name) {
+			add(APIChangeCompatible, cur.Field, "added setter %s of `%s`", name, cur.Field)
+		}
+	}
+
+	if len(oldSetters) != 0 && len(curSetters) != 0 && old.TagOption.Variadic != cur.TagOption.Variadic {
+		add(APIChangeBreaking, cur.Field, "setter %s of `%s` changed: variadic is %t",
+			cur.SetterName, cur.Field, cur.TagOption.Variadic)
+	}
+}
+
+func ownSetters(opt templateOptionMeta) []string {
+	if !opt.HasSetter() {
+		return nil
+	}
+
+	return append([]string{opt.SetterName}, opt.AliasNames...)
+}
+
+func diffGroups(base, current []templateGroup, add func(APIChangeKind, string, string, ...any)) {
+	params := func(group templateGroup) []string {
+		res := make([]string, len(group.Options))
+		for i, opt := range group.Options {
+			res[i] = opt.Field + " " + opt.Type + " " + strconv.FormatBool(opt.TagOption.Variadic)
+		}
+
+		return res
+	}
+
+	currentByName := make(map[string]templateGroup, len(current))
+	for _, group := range current {
+		currentByName[group.SetterName] = group
+	}
+
+	baseByName := make(map[string]templateGroup, len(base))
+	for _, group := range base {
+		baseByName[group.SetterName] = group
+
+		cur, ok := currentByName[group.SetterName]
+		switch {
+		case !ok:
+			add(APIChangeBreaking, "", "removed group setter %s", group.SetterName)
+		case !slices.Equal(params(group), params(cur)):
+			add(APIChangeBreaking, "", "parameters of group setter %s changed", group.SetterName)
+		}
+	}
+
+	for _, group := range current {
+		if _, ok := baseByName[group.SetterName]; !ok {
+			add(APIChangeCompatible, "", "added group setter %s", group.SetterName)
+		}
+	}
+}
+
+// commonMandatory returns mandatory fields, which are mandatory in both
+// revisions, in the order of the constructor parameters.
+func commonMandatory(options []templateOptionMeta, other map[string]templateOptionMeta) []string {
+	var res []string
+	for _, opt := range options {
+		if opt.TagOption.IsRequired && other[opt.Field].TagOption.IsRequired {
+			res = append(res, opt.Field)
+		}
+	}
+
+	return res
+}
+
+func describeDefault(value string) string {
+	if value == "" {
+		return "none"
+	}
+
+	return strconv.Quote(value)
+}
@@ -0,0 +1,98 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAPI(t *testing.T) {
+	t.Parallel()
+
+	opt := func(field, typ string, tag TagOption) OptionMeta {
+		return OptionMeta{Name: strings.ToUpper(field[:1]) + field[1:], Field: field, Type: typ, TagOption: tag}
+	}
+
+	diff := func(base, current []OptionMeta) []APIChange {
+		return DiffAPI(
+			NewOptions(WithOptionsStructName("Options"), WithSpec(&OptionSpec{Options: base})),
+			NewOptions(WithOptionsStructName("Options"), WithSpec(&OptionSpec{Options: current})),
+		)
+	}
+
+	t.Run("no_changes", func(t *testing.T) {
+		t.Parallel()
+
+		options := []OptionMeta{opt("addr", "string", TagOption{IsRequired: true})}
+		assert.Empty(t, diff(options, options))
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+
+		base := []OptionMeta{
+			{Name: "Addr", Field: "addr", Type: "string", TagOption: TagOption{IsRequired: true}},
+			{Name: "Timeout", Field: "timeout", Type: "int", TagOption: TagOption{Default: "1"}},
+			{Name: "Retries", Field: "retries", Type: "int"},
+			{Name: "Debug", Field: "debug", Type: "bool"},
+		}
+		current := []OptionMeta{
+			{Name: "Addr", Field: "addr", Type: "string", TagOption: TagOption{IsRequired: true}},
+			{Name: "Timeout", Field: "timeout", Type: "int64", TagOption: TagOption{Default: "2"}},
+			{Name: "Retries", Field: "retries", Type: "int", TagOption: TagOption{IsRequired: true}},
+			{Name: "Name", Field: "name", Type: "string"},
+			{Name: "Port", Field: "port", Type: "int", TagOption: TagOption{IsRequired: true}},
+		}
+
+		assert.Equal(t, []APIChange{
+			{Kind: APIChangeBreaking, Field: "timeout", Message: "type of `timeout` changed from int to int64"},
+			{Kind: APIChangeBehavioural, Field: "timeout", Message: "default of `timeout` changed from \"1\" to \"2\""},
+			{Kind: APIChangeBreaking, Field: "retries", Message: "field `retries` became a mandatory parameter of the constructor"},
+			{Kind: APIChangeCompatible, Field: "name", Message: "added optional field `name` with setter WithName"},
+			{Kind: APIChangeBreaking, Field: "port", Message: "new mandatory parameter `port` of the constructor"},
+			{Kind: APIChangeBreaking, Field: "debug", Message: "removed field `debug` with setter WithDebug"},
+		}, diff(base, current))
+	})
+
+	t.Run("setters", func(t *testing.T) {
+		t.Parallel()
+
+		base := []OptionMeta{
+			{Name: "Timeout", Field: "timeout", Type: "int", TagOption: TagOption{Aliases: []string{"Deadline"}}},
+			{Name: "Tags", Field: "tags", Type: "string", TagOption: TagOption{Variadic: true}},
+			{Name: "Cert", Field: "cert", Type: "string", TagOption: TagOption{Group: "TLS"}},
+		}
+		current := []OptionMeta{
+			{Name: "Timeout", Field: "timeout", Type: "int", TagOption: TagOption{Name: "Wait"}},
+			{Name: "Tags", Field: "tags", Type: "[]string"},
+			{Name: "Cert", Field: "cert", Type: "string", TagOption: TagOption{Group: "TLS"}},
+			{Name: "Key", Field: "key", Type: "string", TagOption: TagOption{Group: "TLS"}},
+		}
+
+		assert.Equal(t, []APIChange{
+			{Kind: APIChangeBreaking, Field: "timeout", Message: "removed setter WithTimeout of `timeout`"},
+			{Kind: APIChangeBreaking, Field: "timeout", Message: "removed setter WithDeadline of `timeout`"},
+			{Kind: APIChangeCompatible, Field: "timeout", Message: "added setter WithWait of `timeout`"},
+			{Kind: APIChangeBreaking, Field: "tags", Message: "setter WithTags of `tags` changed: variadic is false"},
+			{Kind: APIChangeBreaking, Field: "tags", Message: "type of `tags` changed from string to []string"},
+			{Kind: APIChangeCompatible, Field: "key", Message: "added optional field `key` with setter WithTLS"},
+			{Kind: APIChangeBreaking, Field: "", Message: "parameters of group setter WithTLS changed"},
+		}, diff(base, current))
+	})
+
+	t.Run("constructor_order", func(t *testing.T) {
+		t.Parallel()
+
+		base := []OptionMeta{
+			opt("addr", "string", TagOption{IsRequired: true}),
+			opt("port", "int", TagOption{IsRequired: true}),
+		}
+		current := []OptionMeta{base[1], base[0]}
+
+		assert.Equal(t, []APIChange{
+			{Kind: APIChangeBreaking, Field: "", Message: "order of the constructor parameters changed"},
+		}, diff(base, current))
+	})
+}
@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultsFile contains default values that were read from a JSON/YAML file.
+type DefaultsFile struct {
+	Path   string            // path to the file as it was given by user.
+	Hash   string            // sha256 of the file content.
+	Values map[string]string // field name => default value.
+}
+
+// LoadDefaultsFile read the defaults file by filePath. File should contain a
+// flat object, which is keyed by field names. YAML is a superset of JSON, so
+// both formats are supported.
+func LoadDefaultsFile(filePath string) (*DefaultsFile, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read defaults file: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse defaults file: %w", err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for fieldName, value := range raw {
+		strValue, err := defaultValueString(value)
+		if err != nil {
+			return nil, fmt.Errorf("field `%s`: %w", fieldName, err)
+		}
+
+		values[fieldName] = strValue
+	}
+
+	hash := sha256.Sum256(data)
+
+	return &DefaultsFile{
+		Path:   filepath.ToSlash(filePath),
+		Hash:   hex.EncodeToString(hash[:]),
+		Values: values,
+	}, nil
+}
+
+// Apply will set default values to the options. It checks the values the same
+// way as defaults from tag are checked.
+func (f *DefaultsFile) Apply(options []OptionMeta) error {
+	known := make(map[string]struct{}, len(options))
+	for i := range options {
+		optMeta := &options[i]
+		known[optMeta.Field] = struct{}{}
+
+		value, ok := f.Values[optMeta.Field]
+		if !ok {
+			continue
+		}
+
+		if optMeta.TagOption.IsRequired {
+			return fmt.Errorf("field `%s`: mandatory option cannot have a default value", optMeta.Field)
+		}
+
+		if err := checkDefaultValue(optMeta.ParseType(), value); err != nil {
+			return fmt.Errorf("field `%s`: invalid value in defaults file: %w", optMeta.Field, err)
+		}
+
+		optMeta.TagOption.Default = value
+	}
+
+	var unknown []string
+	for fieldName := range f.Values {
+		if _, ok := known[fieldName]; !ok {
+			unknown = append(unknown, fieldName)
+		}
+	}
+
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+
+		return fmt.Errorf("defaults file contains unknown fields: %v", unknown)
+	}
+
+	return nil
+}
+
+func defaultValueString(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int:
+		return strconv.Itoa(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case uint64:
+		return strconv.FormatUint(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64), nil
+	case nil:
+		return "", errors.New("value is empty")
+	default:
+		return "", fmt.Errorf("unsupported value type `%T`", value)
+	}
+}
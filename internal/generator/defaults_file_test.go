@@ -0,0 +1,118 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDefaultsFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "defaults.yaml")
+		writeTestFile(t, filename, "timeout: 3s\nretries: 5\nratio: 0.5\ndebug: true\nname: joe\n")
+
+		defaultsFile, err := LoadDefaultsFile(filename)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"timeout": "3s",
+			"retries": "5",
+			"ratio":   "0.5",
+			"debug":   "true",
+			"name":    "joe",
+		}, defaultsFile.Values)
+		require.Len(t, defaultsFile.Hash, 64)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "defaults.json")
+		writeTestFile(t, filename, `{"timeout": "3s", "retries": 5}`)
+
+		defaultsFile, err := LoadDefaultsFile(filename)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"timeout": "3s", "retries": "5"}, defaultsFile.Values)
+	})
+
+	t.Run("nested_value", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "defaults.yaml")
+		writeTestFile(t, filename, "timeout:\n  value: 3s\n")
+
+		_, err := LoadDefaultsFile(filename)
+		require.ErrorContains(t, err, "field `timeout`: unsupported value type")
+	})
+
+	t.Run("not_exists", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadDefaultsFile(filepath.Join(t.TempDir(), "defaults.yaml"))
+		require.Error(t, err)
+	})
+}
+
+func TestDefaultsFile_Apply(t *testing.T) {
+	t.Parallel()
+
+	makeOptions := func() []OptionMeta {
+		return []OptionMeta{
+			{Field: "timeout", Type: "time.Duration"},
+			{Field: "retries", Type: "int"},
+			{Field: "name", Type: "string", TagOption: TagOption{IsRequired: true}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		values  map[string]string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "applied",
+			values: map[string]string{"timeout": "3s", "retries": "5"},
+			want:   []string{"3s", "5", ""},
+		},
+		{
+			name:    "bad_value",
+			values:  map[string]string{"retries": "five"},
+			wantErr: "field `retries`: invalid value in defaults file",
+		},
+		{
+			name:    "mandatory",
+			values:  map[string]string{"name": "joe"},
+			wantErr: "field `name`: mandatory option cannot have a default value",
+		},
+		{
+			name:    "unknown_fields",
+			values:  map[string]string{"b": "1", "a": "2"},
+			wantErr: "defaults file contains unknown fields: [a b]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := makeOptions()
+			err := (&DefaultsFile{Values: tc.values}).Apply(options)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			for i := range options {
+				require.Equal(t, tc.want[i], options[i].TagOption.Default)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GetDefaultsFromSource finds the defaults variable (or function) in the
+// package of filePath and returns the source code of the default values by
+// field name. Only composite literals are supported: the variable value or
+// the value returned by the function. Returns nil when nothing was found.
+func GetDefaultsFromSource(filePath, varName, funcName string) (map[string]string, error) {
+	fset, files, err := parsePackageFiles(filePath, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		var lit *ast.CompositeLit
+		switch {
+		case varName != "":
+			lit = findDefaultsVar(file, varName)
+		case funcName != "":
+			lit = findDefaultsFunc(file, funcName)
+		}
+
+		if lit != nil {
+			return compositeLitValues(fset, lit)
+		}
+	}
+
+	return nil, nil
+}
+
+// parsePackageFiles parses non-test files of the package of filePath.
+func parsePackageFiles(filePath string, mode parser.Mode) (*token.FileSet, []*ast.File, error) {
+	fileNames, err := filepath.Glob(filepath.Join(filepath.Dir(filePath), "*.go"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot list package files: %w", err)
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		if strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+
+		source, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read file: %w", err)
+		}
+
+		file, err := parser.ParseFile(fset, fileName, source, mode)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot parse file: %w", err)
+		}
+
+		files = append(files, file)
+	}
+
+	return fset, files, nil
+}
+
+func findDefaultsVar(file *ast.File, varName string) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec) //nolint:forcetypeassert
+			for i, name := range valueSpec.Names {
+				if name.Name == varName && i < len(valueSpec.Values) {
+					return asCompositeLit(valueSpec.Values[i])
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func findDefaultsFunc(file *ast.File, funcName string) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != funcName || funcDecl.Body == nil {
+			continue
+		}
+
+		var lit *ast.CompositeLit
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ReturnStmt:
+				if len(node.Results) == 1 {
+					if res := asCompositeLit(node.Results[0]); res != nil {
+						lit = res
+					}
+				}
+			}
+
+			return true
+		})
+
+		return lit
+	}
+
+	return nil
+}
+
+func asCompositeLit(expr ast.Expr) *ast.CompositeLit {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+
+	lit, _ := expr.(*ast.CompositeLit)
+
+	return lit
+}
+
+func compositeLitValues(fset *token.FileSet, lit *ast.CompositeLit) (map[string]string, error) {
+	values := make(map[string]string, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		keyValue, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		key, ok := keyValue.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, keyValue.Value); err != nil {
+			return nil, fmt.Errorf("cannot format value of `%s`: %w", key.Name, err)
+		}
+
+		values[key.Name] = buf.String()
+	}
+
+	return values, nil
+}
@@ -0,0 +1,66 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDefaultsFromSource(t *testing.T) {
+	t.Parallel()
+
+	const source = `package testcase
+
+import "time"
+
+type Options struct {
+	timeout time.Duration
+	name    string
+	retries int
+}
+
+var defaultOptions = Options{
+	timeout: 3 * time.Second,
+	name:    "server",
+}
+
+func getDefaultOptions() *Options {
+	if false {
+		return nil
+	}
+
+	return &Options{retries: 5}
+}
+`
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "options.go"), source)
+	writeTestFile(t, filepath.Join(dir, "options_test.go"), "package testcase\n\nvar defaultOptions = 1\n")
+	filename := filepath.Join(dir, "options.go")
+
+	t.Run("var", func(t *testing.T) {
+		t.Parallel()
+
+		defaults, err := GetDefaultsFromSource(filename, "defaultOptions", "")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"timeout": "3 * time.Second", "name": `"server"`}, defaults)
+	})
+
+	t.Run("func", func(t *testing.T) {
+		t.Parallel()
+
+		defaults, err := GetDefaultsFromSource(filename, "", "getDefaultOptions")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"retries": "5"}, defaults)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		t.Parallel()
+
+		defaults, err := GetDefaultsFromSource(filename, "unknown", "")
+		require.NoError(t, err)
+		require.Nil(t, defaults)
+	})
+}
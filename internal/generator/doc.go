@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+)
+
+type DocFormat string
+
+const (
+	DocFormatMarkdown DocFormat = "markdown"
+	DocFormatHTML     DocFormat = "html"
+)
+
+var (
+	docMarkdownTmpl = template.Must(template.New("doc.md.tpl").Funcs(template.FuncMap{
+		"mdCode": markdownCode,
+		"mdText": markdownText,
+	}).ParseFS(templates, "templates/doc.md.tpl"))
+	docHTMLTmpl = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/doc.html.tpl"))
+)
+
+type docRow struct {
+	Setter     string
+	Field      string
+	Type       string
+	Default    string
+	Mandatory  bool
+	Validation string
+	Doc        string
+}
+
+// RenderDoc will render the table that describes the options. Default values
+// are taken from defaults by field name, when defaults is nil - from tags.
+func RenderDoc(format DocFormat, opts Options, defaults map[string]string) ([]byte, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("bad configuration: %w", err)
+	}
+
+	options := makeTemplateOptions(opts)
+	rows := make([]docRow, 0, len(options))
+	for _, opt := range options {
+		fieldType := opt.Type
+		if opt.TagOption.Variadic {
+			fieldType = "[]" + fieldType
+		}
+
+		defaultValue := opt.TagOption.Default
+		if defaults != nil {
+			defaultValue = defaults[opt.Field]
+		}
+
+		rows = append(rows, docRow{
+			Setter:     opt.PublicSetterName(),
+			Field:      opt.Field,
+			Type:       fieldType,
+			Default:    strings.Join(strings.Fields(defaultValue), " "),
+			Mandatory:  opt.TagOption.IsRequired,
+			Validation: opt.TagOption.GoValidator,
+			Doc:        opt.Doc,
+		})
+	}
+
+	tplContext := map[string]interface{}{
+		"structName": opts.optionsStructName,
+		"rows":       rows,
+	}
+
+	buf := new(bytes.Buffer)
+
+	var err error
+	switch format {
+	case DocFormatMarkdown:
+		err = docMarkdownTmpl.Execute(buf, tplContext)
+	case DocFormatHTML:
+		err = docHTMLTmpl.Execute(buf, tplContext)
+	default:
+		return nil, fmt.Errorf("unknown doc format: %s", format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot render doc template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func markdownText(s string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(s), " "), "|", `\|`)
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	return "`" + markdownText(s) + "`"
+}
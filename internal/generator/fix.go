@@ -0,0 +1,463 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// FixedFile is a file rewritten by Fix.
+type FixedFile struct {
+	Filename string
+	Content  []byte
+}
+
+type fixEdit struct {
+	offset int
+	length int
+	text   string
+}
+
+// Fix migrates the options struct of filePath: the deprecated `required`
+// option becomes `mandatory`, and `not-empty` is folded into the `validate`
+// tag. With unexport public fields are renamed together with their
+// references within the package, including tests. Only changed files are
+// returned; comments and formatting are kept.
+func Fix(filePath, structName string, unexport bool) ([]FixedFile, []string, error) {
+	filePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get absolute path: %w", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse file: %w", err)
+	}
+
+	fields, err := findFixStruct(file, structName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edits := make(map[string][]fixEdit)
+	for _, field := range fields {
+		if field.Tag == nil {
+			continue
+		}
+
+		tag, changed := fixTag(field.Tag.Value)
+		if changed {
+			edits[filePath] = append(edits[filePath], fixEdit{
+				offset: fset.Position(field.Tag.Pos()).Offset,
+				length: len(field.Tag.Value),
+				text:   tag,
+			})
+		}
+	}
+
+	var warnings []string
+	if unexport {
+		renames, renameWarnings := unexportNames(fields)
+		warnings = renameWarnings
+
+		if len(renames) != 0 {
+			methodWarnings, err := addRenameEdits(filePath, structName, renames, edits)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			warnings = append(warnings, methodWarnings...)
+		}
+	}
+
+	res := make([]FixedFile, 0, len(edits))
+	for filename, fileEdits := range edits {
+		content, err := applyFixEdits(filename, fileEdits)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res = append(res, FixedFile{Filename: filename, Content: content})
+	}
+
+	slices.SortFunc(res, func(a, b FixedFile) int { return strings.Compare(a.Filename, b.Filename) })
+
+	return res, warnings, nil
+}
+
+func findFixStruct(file *ast.File, structName string) ([]*ast.Field, error) {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec) //nolint:forcetypeassert
+			if typeSpec.Name.Name != structName {
+				continue
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				return nil, fmt.Errorf("`%s` is not a struct declared in the file", structName)
+			}
+
+			return structType.Fields.List, nil
+		}
+	}
+
+	return nil, fmt.Errorf("struct `%s` not found", structName)
+}
+
+type structTagPair struct {
+	key   string
+	value string
+}
+
+// fixTag rewrites deprecated options of the raw tag literal.
+func fixTag(literal string) (string, bool) {
+	raw, err := strconv.Unquote(literal)
+	if err != nil {
+		return literal, false
+	}
+
+	pairs, ok := parseStructTag(raw)
+	if !ok {
+		return literal, false
+	}
+
+	optionIdx := slices.IndexFunc(pairs, func(p structTagPair) bool { return p.key == "option" })
+	if optionIdx < 0 {
+		return literal, false
+	}
+
+	var (
+		options  []string
+		changed  bool
+		notEmpty bool
+	)
+
+	for _, opt := range strings.Split(pairs[optionIdx].value, ",") {
+		switch opt {
+		case "required":
+			opt, changed = "mandatory", true
+		case "not-empty":
+			notEmpty, changed = true, true
+
+			continue
+		}
+
+		if !slices.Contains(options, opt) {
+			options = append(options, opt)
+		}
+	}
+
+	if !changed {
+		return literal, false
+	}
+
+	pairs[optionIdx].value = strings.Join(options, ",")
+	if pairs[optionIdx].value == "" {
+		pairs = slices.Delete(pairs, optionIdx, optionIdx+1)
+	}
+
+	if notEmpty {
+		validateIdx := slices.IndexFunc(pairs, func(p structTagPair) bool { return p.key == "validate" })
+		switch {
+		case validateIdx < 0:
+			pairs = append(pairs, structTagPair{key: "validate", value: "required"})
+		case !slices.Contains(splitValidationRules(pairs[validateIdx].value), "required"):
+			pairs[validateIdx].value = strings.TrimPrefix(pairs[validateIdx].value+",required", ",")
+		}
+	}
+
+	return renderStructTag(pairs), true
+}
+
+// renderStructTag returns the tag literal of pairs.
+func renderStructTag(pairs []structTagPair) string {
+	parts := make([]string, len(pairs))
+	for i, pair := range pairs {
+		parts[i] = pair.key + ":" + strconv.Quote(pair.value)
+	}
+
+	tag := strings.Join(parts, " ")
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+
+	return "`" + tag + "`"
+}
+
+// parseStructTag splits the tag into pairs in the order of declaration, the
+// same way as reflect.StructTag.Lookup does.
+func parseStructTag(tag string) ([]structTagPair, bool) {
+	var pairs []structTagPair
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			break
+		}
+
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, false
+		}
+
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+
+		if i >= len(tag) {
+			return nil, false
+		}
+
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return nil, false
+		}
+
+		pairs = append(pairs, structTagPair{key: key, value: value})
+		tag = tag[i+1:]
+	}
+
+	return pairs, true
+}
+
+// unexportNames returns new names for public fields of options.
+func unexportNames(fields []*ast.Field) (map[string]string, []string) {
+	names := make(map[string]struct{})
+	for _, field := range fields {
+		for _, name := range field.Names {
+			names[name.Name] = struct{}{}
+		}
+	}
+
+	renames := make(map[string]string)
+
+	var warnings []string
+	for _, field := range fields {
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+			if tag.Get("option") == "-" {
+				continue
+			}
+		}
+
+		for _, name := range field.Names {
+			if !isPublic(name.Name) {
+				continue
+			}
+
+			newName := unexportName(name.Name)
+			if token.IsKeyword(newName) {
+				warnings = append(warnings, fmt.Sprintf("Warning: cannot unexport `%s`: `%s` is a keyword", name.Name, newName))
+
+				continue
+			}
+
+			if _, ok := names[newName]; ok {
+				warnings = append(warnings, unexportConflictWarning(name.Name, newName))
+
+				continue
+			}
+
+			names[newName] = struct{}{}
+			renames[name.Name] = newName
+		}
+	}
+
+	return renames, warnings
+}
+
+func unexportConflictWarning(name, newName string) string {
+	return fmt.Sprintf("Warning: cannot unexport `%s`: `%s` is already used", name, newName)
+}
+
+// unexportName lowers the first word: Timeout is timeout, HTTPClient is
+// httpClient and URL is url.
+func unexportName(name string) string {
+	runes := []rune(name)
+
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+
+	for i := range n {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+// addRenameEdits renames fields and all their uses in the package and its
+// tests. Fields, which new names are used by methods, are not renamed.
+func addRenameEdits(
+	filePath, structName string,
+	renames map[string]string,
+	edits map[string][]fixEdit,
+) ([]string, error) {
+	fset := token.NewFileSet()
+	cfg := &packages.Config{ //nolint:exhaustruct
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports |
+			packages.NeedDeps | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:   filepath.Dir(filePath),
+		Fset:  fset,
+		Tests: true,
+	}
+
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, fmt.Errorf("cannot load package: %w", err)
+	}
+
+	var errs []error
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err)
+		}
+	})
+
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("package has errors: %w", errors.Join(errs...))
+	}
+
+	var warnings []string
+	for _, pkg := range pkgs {
+		obj := pkg.Types.Scope().Lookup(structName)
+		if obj == nil || fset.Position(obj.Pos()).Filename != filePath {
+			continue
+		}
+
+		methods := types.NewMethodSet(types.NewPointer(obj.Type()))
+		for _, name := range slices.Sorted(maps.Keys(renames)) {
+			if methods.Lookup(pkg.Types, renames[name]) != nil {
+				warnings = append(warnings, unexportConflictWarning(name, renames[name]))
+				delete(renames, name)
+			}
+		}
+
+		break
+	}
+
+	type fieldKey struct {
+		filename string
+		offset   int
+	}
+
+	// NOTE: test variants of the package have their own objects, so fields
+	// are identified by their positions.
+	targets := make(map[fieldKey]string)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			if fset.Position(file.Pos()).Filename != filePath {
+				continue
+			}
+
+			fields, err := findFixStruct(file, structName)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, field := range fields {
+				for _, name := range field.Names {
+					if newName, ok := renames[name.Name]; ok {
+						pos := fset.Position(name.Pos())
+						targets[fieldKey{filename: pos.Filename, offset: pos.Offset}] = newName
+					}
+				}
+			}
+		}
+	}
+
+	seen := make(map[fieldKey]struct{})
+	for _, pkg := range pkgs {
+		addEdit := func(ident *ast.Ident, obj types.Object) {
+			field, ok := obj.(*types.Var)
+			if !ok || !field.IsField() {
+				return
+			}
+
+			declPos := fset.Position(field.Pos())
+			newName, ok := targets[fieldKey{filename: declPos.Filename, offset: declPos.Offset}]
+			if !ok {
+				return
+			}
+
+			pos := fset.Position(ident.Pos())
+			key := fieldKey{filename: pos.Filename, offset: pos.Offset}
+			if _, ok := seen[key]; ok {
+				return
+			}
+
+			seen[key] = struct{}{}
+			edits[pos.Filename] = append(edits[pos.Filename], fixEdit{
+				offset: pos.Offset,
+				length: len(ident.Name),
+				text:   newName,
+			})
+		}
+
+		for ident, obj := range pkg.TypesInfo.Defs {
+			addEdit(ident, obj)
+		}
+
+		for ident, obj := range pkg.TypesInfo.Uses {
+			addEdit(ident, obj)
+		}
+	}
+
+	return warnings, nil
+}
+
+func applyFixEdits(filename string, edits []fixEdit) ([]byte, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read file: %w", err)
+	}
+
+	slices.SortFunc(edits, func(a, b fixEdit) int { return b.offset - a.offset })
+
+	for _, edit := range edits {
+		content = slices.Concat(content[:edit.offset], []byte(edit.text), content[edit.offset+edit.length:])
+	}
+
+	formatted, err := format.Source(content)
+	if err != nil {
+		return nil, fmt.Errorf("cannot format %s: %w", filename, err)
+	}
+
+	return formatted, nil
+}
@@ -0,0 +1,90 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		changed bool
+	}{
+		{"no_option", "`default:\"1\"`", "`default:\"1\"`", false},
+		{"up_to_date", "`option:\"mandatory\"`", "`option:\"mandatory\"`", false},
+		{"required", "`option:\"required\"`", "`option:\"mandatory\"`", true},
+		{"required_twice", "`option:\"mandatory,required\"`", "`option:\"mandatory\"`", true},
+		{"required_keeps_others", "`option:\"required,variadic=true\" json:\"a\"`",
+			"`option:\"mandatory,variadic=true\" json:\"a\"`", true},
+		{"not_empty", "`option:\"not-empty\"`", "`validate:\"required\"`", true},
+		{"not_empty_with_validate", "`option:\"not-empty\" validate:\"min=1\"`",
+			"`validate:\"min=1,required\"`", true},
+		{"not_empty_with_required_rule", "`option:\"mandatory,not-empty\" validate:\"required\"`",
+			"`option:\"mandatory\" validate:\"required\"`", true},
+		{"malformed", "`option:required`", "`option:required`", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, changed := fixTag(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.changed, changed)
+		})
+	}
+}
+
+func TestUnexportName(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"Timeout":    "timeout",
+		"HTTPClient": "httpClient",
+		"URL":        "url",
+		"ID":         "id",
+		"MaxRetries": "maxRetries",
+		"A":          "a",
+	} {
+		assert.Equal(t, want, unexportName(in), in)
+	}
+}
+
+func TestUnexportNames(t *testing.T) {
+	t.Parallel()
+
+	const src = "package p\n\ntype Options struct {\n" +
+		"\tTimeout int\n" +
+		"\ttimeout int\n" +
+		"\tType    string\n" +
+		"\tSkipped int `option:\"-\"`\n" +
+		"\tURL     string\n" +
+		"\tdebug   bool\n" +
+		"}\n"
+
+	file, err := parser.ParseFile(token.NewFileSet(), "p.go", src, 0)
+	require.NoError(t, err)
+
+	fields, err := findFixStruct(file, "Options")
+	require.NoError(t, err)
+
+	renames, warnings := unexportNames(fields)
+	assert.Equal(t, map[string]string{"URL": "url"}, renames)
+	assert.Equal(t, []string{
+		"Warning: cannot unexport `Timeout`: `timeout` is already used",
+		"Warning: cannot unexport `Type`: `type` is a keyword",
+	}, warnings)
+
+	_, err = findFixStruct(&ast.File{}, "Options")
+	require.EqualError(t, err, "struct `Options` not found")
+}
@@ -0,0 +1,360 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	funcDirective  = "//options-gen:func"
+	paramDirective = "//options-gen:param"
+)
+
+// FuncSpec is a function marked with the //options-gen:func directive. Its
+// parameters become fields of the generated params struct.
+type FuncSpec struct {
+	Name   string
+	Params []FuncParam
+	// Results are types of the function results.
+	Results []string
+}
+
+// FuncParam is a parameter of the function. Type of the variadic parameter
+// is a slice.
+type FuncParam struct {
+	Name      string
+	Type      string
+	Tag       string
+	Mandatory bool
+	Variadic  bool
+}
+
+// ReturnsError reports whether the last result of the function is an error.
+func (f *FuncSpec) ReturnsError() bool {
+	return len(f.Results) != 0 && f.Results[len(f.Results)-1] == "error"
+}
+
+// GetFuncOptionSpec reads the function funcName of the package of filePath,
+// which is marked by directives:
+//
+//	//options-gen:func mandatory=1
+//	//options-gen:param timeout default:"5s" validate:"min=1s"
+//	func Dial(addr string, timeout time.Duration) (*Conn, error)
+//
+// The first mandatory parameters are mandatory options, tags of others are
+// set by param directives.
+func GetFuncOptionSpec(
+	filePath, funcName, tagName string,
+	allVariadic bool,
+	excludes []*regexp.Regexp,
+) (*GetOptionSpecRes, *FuncSpec, error) {
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("source file not exist: %w", syscall.ENOENT)
+	}
+
+	fset := token.NewFileSet()
+	typed, decl, err := findTypedFunc(fset, filePath, funcName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if decl.Type.TypeParams != nil {
+		return nil, nil, fmt.Errorf("function `%s`: generic functions are not supported", funcName)
+	}
+
+	funcSpec, fields, err := makeFuncSpec(decl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("function `%s`: %w", funcName, err)
+	}
+
+	spec, err := getOptionSpec(fset, path.Dir(filePath), typed.file, typed, nil, fields, tagName, allVariadic, excludes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return spec, funcSpec, nil
+}
+
+func findFuncDecl(files []*ast.File, funcName string) (*ast.File, *ast.FuncDecl) {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if ok && funcDecl.Recv == nil && funcDecl.Name.Name == funcName {
+				return file, funcDecl
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// resultVarPattern matches names of variables, which keep results of the
+// function in the wrapper.
+var resultVarPattern = regexp.MustCompile(`^res[0-9]+$`)
+
+// makeFuncSpec returns the spec of the function and fields of the params
+// struct with tags from directives.
+func makeFuncSpec(decl *ast.FuncDecl) (*FuncSpec, []*ast.Field, error) {
+	mandatory, tags, err := parseFuncDirectives(decl.Doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	funcSpec := &FuncSpec{Name: decl.Name.Name}
+
+	var fields []*ast.Field
+	names := make(map[string]struct{})
+	for _, param := range decl.Type.Params.List {
+		typeExpr := param.Type
+		ellipsis, variadic := typeExpr.(*ast.Ellipsis)
+		if variadic {
+			typeExpr = &ast.ArrayType{Elt: ellipsis.Elt} //nolint:exhaustruct
+		}
+
+		paramNames := param.Names
+		if len(paramNames) == 0 {
+			paramNames = []*ast.Ident{ast.NewIdent("_")}
+		}
+
+		for _, name := range paramNames {
+			// NOTE: unnamed and blank parameters are named by their
+			// positions, like arg0.
+			if name.Name == "_" {
+				name = ast.NewIdent("arg" + strconv.Itoa(len(funcSpec.Params)))
+			}
+
+			if _, ok := names[name.Name]; ok {
+				return nil, nil, fmt.Errorf("parameter `%s` conflicts with the name of an unnamed parameter", name.Name)
+			}
+
+			names[name.Name] = struct{}{}
+
+			funcParam := FuncParam{
+				Name:      name.Name,
+				Type:      types.ExprString(typeExpr),
+				Tag:       "",
+				Mandatory: len(funcSpec.Params) < mandatory,
+				Variadic:  variadic,
+			}
+
+			tag, ok := tags[name.Name]
+			delete(tags, name.Name)
+
+			if funcParam.Mandatory {
+				if slices.Contains([]string{"o", "options", "err"}, name.Name) || resultVarPattern.MatchString(name.Name) {
+					return nil, nil, fmt.Errorf("mandatory parameter `%s` conflicts with generated code", name.Name)
+				}
+
+				tag, err = addTagOptions(tagLit(tag, ok), []string{"mandatory"})
+				if err != nil {
+					return nil, nil, fmt.Errorf("parameter `%s`: %w", name.Name, err)
+				}
+
+				ok = true
+			}
+
+			field := &ast.Field{ //nolint:exhaustruct
+				Names: []*ast.Ident{ast.NewIdent(name.Name)},
+				Type:  typeExpr,
+			}
+
+			if ok {
+				funcParam.Tag = tag
+				field.Tag = tagLit(tag, true)
+			}
+
+			funcSpec.Params = append(funcSpec.Params, funcParam)
+			fields = append(fields, field)
+		}
+	}
+
+	if mandatory > len(funcSpec.Params) {
+		return nil, nil, fmt.Errorf("%d mandatory parameters of %d", mandatory, len(funcSpec.Params))
+	}
+
+	if len(tags) != 0 {
+		return nil, nil, fmt.Errorf("unknown parameter `%s` of %s", slices.Sorted(maps.Keys(tags))[0], paramDirective)
+	}
+
+	if decl.Type.Results != nil {
+		for _, result := range decl.Type.Results.List {
+			for range max(len(result.Names), 1) {
+				funcSpec.Results = append(funcSpec.Results, types.ExprString(result.Type))
+			}
+		}
+	}
+
+	return funcSpec, fields, nil
+}
+
+// parseFuncDirectives returns the number of mandatory parameters and tags of
+// parameters. Tags are literals with backquotes.
+func parseFuncDirectives(doc *ast.CommentGroup) (int, map[string]string, error) {
+	var (
+		marked    bool
+		mandatory int
+		tags      = make(map[string]string)
+	)
+
+	if doc == nil {
+		return 0, nil, fmt.Errorf("function is not marked with %s", funcDirective)
+	}
+
+	for _, comment := range doc.List {
+		if args, ok := cutDirective(comment.Text, funcDirective); ok {
+			marked = true
+
+			for _, arg := range strings.Fields(args) {
+				key, value, _ := strings.Cut(arg, "=")
+				if key != "mandatory" {
+					return 0, nil, fmt.Errorf("unknown argument `%s` of %s", arg, funcDirective)
+				}
+
+				n, err := strconv.Atoi(value)
+				if err != nil || n < 0 {
+					return 0, nil, fmt.Errorf("invalid number of mandatory parameters `%s`", value)
+				}
+
+				mandatory = n
+			}
+
+			continue
+		}
+
+		if args, ok := cutDirective(comment.Text, paramDirective); ok {
+			name, tag, _ := strings.Cut(args, " ")
+			tag = strings.TrimSpace(tag)
+
+			pairs, ok := parseStructTag(tag)
+			if !ok || name == "" || len(pairs) == 0 {
+				return 0, nil, fmt.Errorf("invalid directive `%s`, expected %s name key:\"value\"", comment.Text, paramDirective)
+			}
+
+			if _, ok := tags[name]; ok {
+				return 0, nil, fmt.Errorf("duplicated %s of `%s`", paramDirective, name)
+			}
+
+			tags[name] = renderStructTag(pairs)
+		}
+	}
+
+	if !marked {
+		return 0, nil, fmt.Errorf("function is not marked with %s", funcDirective)
+	}
+
+	return mandatory, tags, nil
+}
+
+// cutDirective returns arguments of the directive comment. gofmt does not
+// treat options-gen directives as directives and adds a space after the
+// slashes, so both forms are accepted.
+func cutDirective(text, directive string) (string, bool) {
+	text = "//" + strings.TrimLeft(strings.TrimPrefix(text, "//"), " \t")
+
+	args, ok := strings.CutPrefix(text, directive)
+	if !ok || (args != "" && args[0] != ' ' && args[0] != '\t') {
+		return "", false
+	}
+
+	return strings.TrimSpace(args), true
+}
+
+// structDecl returns the params struct, which fields are parameters of the
+// function.
+func (f *FuncSpec) structDecl(structName string) *StructDecl {
+	decl := &StructDecl{
+		Doc:    "// " + structName + " holds parameters of " + f.Name + ".",
+		Fields: make([]StructField, len(f.Params)),
+	}
+
+	for i, param := range f.Params {
+		decl.Fields[i] = StructField{Doc: "", Name: param.Name, Type: param.Type, Tag: param.Tag}
+	}
+
+	return decl
+}
+
+func tagLit(tag string, ok bool) *ast.BasicLit {
+	if !ok {
+		return nil
+	}
+
+	return &ast.BasicLit{Kind: token.STRING, Value: tag} //nolint:exhaustruct
+}
+
+type templateFuncWrapper struct {
+	Name          string
+	FuncName      string
+	Doc           string
+	Params        []string
+	MandatoryArgs []string
+	CallArgs      string
+	Results       string
+	ZeroResults   []string
+	ResultVars    string
+	ReturnsError  bool
+}
+
+// makeTemplateFuncWrapper returns the wrapper, which calls the function with
+// the parameters set by options. Returns nil when options are not generated
+// from a function.
+func makeTemplateFuncWrapper(funcSpec *FuncSpec, options []templateOptionMeta) *templateFuncWrapper {
+	if funcSpec == nil {
+		return nil
+	}
+
+	wrapper := &templateFuncWrapper{
+		Name:         funcSpec.Name + "With",
+		FuncName:     funcSpec.Name,
+		ReturnsError: funcSpec.ReturnsError(),
+	}
+
+	wrapper.Doc = "// " + wrapper.Name + " calls " + funcSpec.Name + " with the parameters set by options.\n" +
+		"// Invalid parameters are returned as an error without calling " + funcSpec.Name + "."
+
+	for _, opt := range options {
+		if opt.TagOption.IsRequired {
+			wrapper.Params = append(wrapper.Params, opt.TargetField+" "+opt.Type)
+			wrapper.MandatoryArgs = append(wrapper.MandatoryArgs, opt.TargetField)
+		}
+	}
+
+	callArgs := make([]string, len(funcSpec.Params))
+	for i, param := range funcSpec.Params {
+		callArgs[i] = "o." + param.Name
+		if param.Variadic {
+			callArgs[i] += "..."
+		}
+	}
+
+	wrapper.CallArgs = strings.Join(callArgs, ", ")
+
+	results := funcSpec.Results
+	if wrapper.ReturnsError {
+		wrapper.ZeroResults = results[:len(results)-1]
+	} else {
+		wrapper.ZeroResults = results
+		results = append(slices.Clip(results), "error")
+	}
+
+	wrapper.Results = strings.Join(results, ", ")
+
+	resultVars := make([]string, len(wrapper.ZeroResults))
+	for i := range resultVars {
+		resultVars[i] = "res" + strconv.Itoa(i)
+	}
+
+	wrapper.ResultVars = strings.Join(resultVars, ", ")
+
+	return wrapper
+}
@@ -0,0 +1,128 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeFuncSpec(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, src string) *ast.FuncDecl {
+		t.Helper()
+
+		file, err := parser.ParseFile(token.NewFileSet(), "p.go", "package p\n\n"+src+"\n", parser.ParseComments)
+		require.NoError(t, err)
+
+		return file.Decls[0].(*ast.FuncDecl) //nolint:forcetypeassert
+	}
+
+	t.Run("spec", func(t *testing.T) {
+		t.Parallel()
+
+		funcSpec, fields, err := makeFuncSpec(parse(t, `// Dial dials.
+//
+//options-gen:func mandatory=2
+// options-gen:param port validate:"min=1"
+//options-gen:param timeout default:"1s"
+func Dial(host string, port int, timeout time.Duration, tags ...string) (conn *Conn, err error) {}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, &FuncSpec{
+			Name: "Dial",
+			Params: []FuncParam{
+				{Name: "host", Type: "string", Tag: "`option:\"mandatory\"`", Mandatory: true},
+				{Name: "port", Type: "int", Tag: "`validate:\"min=1\" option:\"mandatory\"`", Mandatory: true},
+				{Name: "timeout", Type: "time.Duration", Tag: "`default:\"1s\"`"},
+				{Name: "tags", Type: "[]string", Variadic: true},
+			},
+			Results: []string{"*Conn", "error"},
+		}, funcSpec)
+		assert.True(t, funcSpec.ReturnsError())
+
+		require.Len(t, fields, 4)
+		assert.Equal(t, "`default:\"1s\"`", fields[2].Tag.Value)
+		assert.Nil(t, fields[3].Tag)
+	})
+
+	t.Run("unnamed", func(t *testing.T) {
+		t.Parallel()
+
+		funcSpec, fields, err := makeFuncSpec(parse(t, `//options-gen:func mandatory=1
+//options-gen:param arg1 default:"2"
+func Sum(int, int, ...int) (int, bool) {}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, []FuncParam{
+			{Name: "arg0", Type: "int", Tag: "`option:\"mandatory\"`", Mandatory: true},
+			{Name: "arg1", Type: "int", Tag: "`default:\"2\"`"},
+			{Name: "arg2", Type: "[]int", Variadic: true},
+		}, funcSpec.Params)
+		require.Len(t, fields, 3)
+		assert.Equal(t, "arg0", fields[0].Names[0].Name)
+
+		funcSpec, _, err = makeFuncSpec(parse(t, "//options-gen:func\nfunc F(a int, _ string) {}"))
+		require.NoError(t, err)
+		assert.Equal(t, "arg1", funcSpec.Params[1].Name)
+	})
+
+	for name, tt := range map[string]struct {
+		src     string
+		wantErr string
+	}{
+		"not_marked":        {"func F(a int) {}", "function is not marked with //options-gen:func"},
+		"other_directive":   {"//options-gen:funcs\nfunc F(a int) {}", "function is not marked with //options-gen:func"},
+		"unknown_argument":  {"//options-gen:func optional=1\nfunc F(a int) {}", "unknown argument `optional=1` of //options-gen:func"},
+		"bad_mandatory":     {"//options-gen:func mandatory=x\nfunc F(a int) {}", "invalid number of mandatory parameters `x`"},
+		"too_many":          {"//options-gen:func mandatory=2\nfunc F(a int) {}", "2 mandatory parameters of 1"},
+		"blank_clash":       {"//options-gen:func\nfunc F(arg1 int, _ string) {}", "parameter `arg1` conflicts with the name of an unnamed parameter"},
+		"result_var":        {"//options-gen:func mandatory=1\nfunc F(res0 int) (int, error) {}", "mandatory parameter `res0` conflicts with generated code"},
+		"unknown_parameter": {"//options-gen:func\n//options-gen:param b default:\"1\"\nfunc F(a int) {}", "unknown parameter `b` of //options-gen:param"},
+		"bad_tag":           {"//options-gen:func\n//options-gen:param a default\nfunc F(a int) {}", "invalid directive `//options-gen:param a default`, expected //options-gen:param name key:\"value\""},
+		"duplicated":        {"//options-gen:func\n//options-gen:param a a:\"1\"\n//options-gen:param a b:\"2\"\nfunc F(a int) {}", "duplicated //options-gen:param of `a`"},
+		"reserved":          {"//options-gen:func mandatory=1\nfunc F(options int) {}", "mandatory parameter `options` conflicts with generated code"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := makeFuncSpec(parse(t, tt.src))
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMakeTemplateFuncWrapper(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, makeTemplateFuncWrapper(nil, nil))
+
+	options := []templateOptionMeta{
+		{OptionMeta: OptionMeta{Field: "addr", Type: "string", TagOption: TagOption{IsRequired: true}}, TargetField: "addr"},
+		{OptionMeta: OptionMeta{Field: "level", Type: "int"}, TargetField: "level"},
+	}
+	params := []FuncParam{{Name: "addr", Mandatory: true}, {Name: "level"}, {Name: "tags", Variadic: true}}
+
+	wrapper := makeTemplateFuncWrapper(&FuncSpec{Name: "Log", Params: params, Results: []string{"int", "bool"}}, options)
+	assert.Equal(t, []string{"addr string"}, wrapper.Params)
+	assert.Equal(t, []string{"addr"}, wrapper.MandatoryArgs)
+	assert.Equal(t, "o.addr, o.level, o.tags...", wrapper.CallArgs)
+	assert.Equal(t, "int, bool, error", wrapper.Results)
+	assert.Equal(t, []string{"int", "bool"}, wrapper.ZeroResults)
+	assert.Equal(t, "res0, res1", wrapper.ResultVars)
+	assert.False(t, wrapper.ReturnsError)
+
+	wrapper = makeTemplateFuncWrapper(&FuncSpec{Name: "Log", Params: params[:1]}, options)
+	assert.Equal(t, "error", wrapper.Results)
+	assert.Empty(t, wrapper.ZeroResults)
+
+	wrapper = makeTemplateFuncWrapper(&FuncSpec{Name: "Log", Params: params[:1], Results: []string{"error"}}, options)
+	assert.Equal(t, "error", wrapper.Results)
+	assert.Empty(t, wrapper.ZeroResults)
+	assert.True(t, wrapper.ReturnsError)
+}
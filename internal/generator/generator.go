@@ -13,6 +13,7 @@ import (
 	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"syscall"
 	"text/template"
@@ -22,19 +23,106 @@ import (
 	"golang.org/x/tools/imports"
 )
 
-//go:embed templates/options.go.tpl
+//go:embed templates/*.tpl
 var templates embed.FS
 
 var tmpl = template.Must(template.ParseFS(templates, "templates/options.go.tpl"))
 
 const generatedFormatTabWidth = 8
 
+// Style is a style of the generated API.
+type Style string
+
+const (
+	// StyleFunctional generates functional options: setters are passed to the
+	// constructor.
+	StyleFunctional Style = "functional"
+	// StyleBuilder generates a builder with chained methods.
+	StyleBuilder Style = "builder"
+)
+
+func (o *Options) builderTypeName() string {
+	return o.optionsStructName + "Builder"
+}
+
+func (o *Options) resolveConstructorName() string {
+	if o.constructorName != "" {
+		return o.constructorName
+	}
+
+	name := o.optionsStructName
+	if o.style == StyleBuilder {
+		name = o.builderTypeName()
+	}
+
+	if o.constructorTypeRender == "private" {
+		return "new" + name
+	}
+
+	return "New" + name
+}
+
+// resolveStructDecl returns the options struct, which is declared by the
+// generated file, or nil when it is declared in the source.
+func (o *Options) resolveStructDecl() *StructDecl {
+	decl := o.structDecl
+	if o.funcSpec != nil {
+		decl = o.funcSpec.structDecl(o.optionsStructName)
+	}
+
+	if decl != nil && o.withSource && o.spec.SourcesField == "" {
+		fields := make([]StructField, 0, len(decl.Fields)+1)
+		fields = append(fields, StructField{Doc: "", Name: sourcesFieldName, Type: "optionsinfo461e464ebed9.Sources", Tag: ""})
+		decl = &StructDecl{Doc: decl.Doc, Fields: append(fields, decl.Fields...)}
+	}
+
+	return decl
+}
+
+// sourcesFieldName is the name of the field, which keeps sources of options
+// in the generated options struct.
+const sourcesFieldName = "optSources"
+
+// resolveSourcesField returns the field of the options struct, which keeps
+// sources of options.
+func (o *Options) resolveSourcesField() (string, error) {
+	if !o.withSource {
+		return "", nil
+	}
+
+	if o.spec.SourcesField != "" {
+		return o.spec.SourcesField, nil
+	}
+
+	if o.resolveStructDecl() == nil {
+		return "", fmt.Errorf("with source: struct `%s` should have a field of type optionsinfo.Sources",
+			o.optionsStructName)
+	}
+
+	for _, opt := range o.spec.Options {
+		if opt.Field == sourcesFieldName {
+			return "", fmt.Errorf("with source: field `%s` is reserved for sources of options", sourcesFieldName)
+		}
+	}
+
+	return sourcesFieldName, nil
+}
+
+// setterNamePattern is used to check names of aliases and groups, which are
+// parts of the setter names.
+var setterNamePattern = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+
 // Render will render file and out it's content.
 func Render(opts Options) ([]byte, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, fmt.Errorf("bad configuration: %w", err)
 	}
 
+	sourcesField, err := opts.resolveSourcesField()
+	if err != nil {
+		return nil, err
+	}
+
 	optionsStructType := opts.optionsStructName
 	optionsStructInstanceType := opts.optionsStructName
 
@@ -43,14 +131,35 @@ func Render(opts Options) ([]byte, error) {
 		optionsStructInstanceType += opts.spec.TypeParams
 	}
 
-	options := makeTemplateOptions(opts.spec.Options)
+	options := makeTemplateOptions(opts)
+	for i := range options {
+		options[i].SetterDoc = setterDoc(options[i], opts)
+	}
+
+	if err := checkSetterNames(options); err != nil {
+		return nil, err
+	}
+
+	groups := makeTemplateGroups(options)
+
+	presets, err := makeTemplatePresets(opts.presets, options, opts.spec.TypeParams != "")
+	if err != nil {
+		return nil, err
+	}
+
+	builderTypeName := opts.builderTypeName()
+	constructorName := opts.resolveConstructorName()
+
 	tplContext := map[string]interface{}{
 		"version":       opts.version,
 		"packageName":   opts.packageName,
 		"imports":       opts.fileImports,
 		"options":       options,
+		"groups":        groups,
+		"presets":       presets,
 		"optionsLen":    len(options),
 		"hasValidation": opts.spec.HasValidation(),
+		"hasEnv":        opts.spec.HasEnv(),
 
 		"optionsTypeParamsSpec": opts.spec.TypeParamsSpec,
 		"optionsTypeParams":     opts.spec.TypeParams,
@@ -63,10 +172,31 @@ func Render(opts Options) ([]byte, error) {
 		"defaultsTagName":           opts.tagName,
 		"defaultsVarName":           opts.varName,
 		"defaultsFuncName":          opts.funcName,
+		"defaultsFile":              opts.defaultsFile,
 
 		"withIsset": opts.withIsset,
+		"envPrefix": opts.envPrefix,
+		"withFlags": opts.withFlags,
+
+		"withDecode":   opts.withDecode,
+		"decodeStrict": opts.decodeStrict,
+
+		"withStringer": opts.withStringer,
+		"withSpec":     opts.withSpec,
+		"withSource":   opts.withSource,
+		"sourcesField": sourcesField,
+
+		"withCombinators": opts.withCombinators,
+
+		"style":           opts.style,
+		"builderTypeName": builderTypeName,
 
 		"constructorTypeRender": opts.constructorTypeRender,
+		"constructorName":       constructorName,
+		"constructorDoc":        constructorDoc(constructorName, options, opts),
+
+		"structDecl":  opts.resolveStructDecl(),
+		"funcWrapper": makeTemplateFuncWrapper(opts.funcSpec, options),
 	}
 	buf := new(bytes.Buffer)
 
@@ -91,7 +221,7 @@ func optimizeGeneratedSource(src []byte) ([]byte, error) {
 		return nil, fmt.Errorf("parse generated source: %w", err)
 	}
 
-	pruneUnusedImports(file)
+	pruneUnusedImports(fset, file)
 	ast.SortImports(fset, file)
 
 	var buf bytes.Buffer
@@ -114,20 +244,15 @@ func optimizeGeneratedSource(src []byte) ([]byte, error) {
 	return formatted, nil
 }
 
-func pruneUnusedImports(file *ast.File) {
-	usedSelectors := make(map[string]struct{})
-	ast.Inspect(file, func(node ast.Node) bool {
-		switch node := node.(type) {
-		case *ast.ImportSpec:
-			return false
-		case *ast.SelectorExpr:
-			if ident, ok := node.X.(*ast.Ident); ok {
-				usedSelectors[ident.Name] = struct{}{}
-			}
-		}
+func pruneUnusedImports(fset *token.FileSet, file *ast.File) {
+	pruneImports(fset, file, func(string) bool { return true })
+}
 
-		return true
-	})
+// pruneImports removes unused imports, which names are accepted by canPrune.
+func pruneImports(fset *token.FileSet, file *ast.File, canPrune func(name string) bool) {
+	usedSelectors := usedSelectorNames(file)
+
+	var prunedLines []int
 
 	importDecls := file.Decls[:0]
 	for _, decl := range file.Decls {
@@ -148,8 +273,10 @@ func pruneUnusedImports(file *ast.File) {
 				continue
 			}
 
-			if _, ok := usedSelectors[importName]; ok {
+			if _, ok := usedSelectors[importName]; ok || !canPrune(importName) {
 				importSpecs = append(importSpecs, spec)
+			} else {
+				prunedLines = append(prunedLines, fset.Position(imp.Pos()).Line)
 			}
 		}
 
@@ -162,6 +289,36 @@ func pruneUnusedImports(file *ast.File) {
 	}
 
 	file.Decls = importDecls
+
+	// Merge lines of pruned imports with the next ones, otherwise they will be
+	// printed as blank lines and split imports into groups.
+	tokenFile := fset.File(file.Pos())
+	sort.Sort(sort.Reverse(sort.IntSlice(prunedLines)))
+	for _, line := range prunedLines {
+		if line < tokenFile.LineCount() {
+			tokenFile.MergeLine(line)
+		}
+	}
+}
+
+// usedSelectorNames returns names of identifiers, which are used as X in
+// selector expressions: package names and variables.
+func usedSelectorNames(file *ast.File) map[string]struct{} {
+	res := make(map[string]struct{})
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.ImportSpec:
+			return false
+		case *ast.SelectorExpr:
+			if ident, ok := node.X.(*ast.Ident); ok {
+				res[ident.Name] = struct{}{}
+			}
+		}
+
+		return true
+	})
+
+	return res
 }
 
 func importSpecName(imp *ast.ImportSpec) string {
@@ -179,30 +336,243 @@ func importSpecName(imp *ast.ImportSpec) string {
 
 type templateOptionMeta struct {
 	OptionMeta
-	TargetName  string
-	TargetField string
+	TargetName      string
+	TargetField     string
+	SetterName      string
+	GroupSetterName string
+	AliasNames      []string
+	ParseExpr       string
+	FlagName        string
+	FlagUsage       string
+	StringExpr      string
+	LogValueExpr    string
+	Doc             string
+	SetterDoc       string
+	SpecDefault     string
+}
+
+// HasSetter reports whether the option has its own setter.
+func (o templateOptionMeta) HasSetter() bool {
+	return !o.TagOption.IsRequired && (o.TagOption.Group == "" || o.TagOption.Standalone)
+}
+
+// PublicSetterName returns the name of the setter that sets the option: its
+// own setter or the group setter.
+func (o templateOptionMeta) PublicSetterName() string {
+	if o.HasSetter() {
+		return o.SetterName
+	}
+
+	return o.GroupSetterName
+}
+
+type templateGroup struct {
+	SetterName string
+	SetterDoc  string
+	Options    []templateOptionMeta
+}
+
+// makeTemplateGroups collects grouped options in declaration order.
+func makeTemplateGroups(options []templateOptionMeta) []templateGroup {
+	var groups []templateGroup
+	index := make(map[string]int)
+	for _, opt := range options {
+		if opt.TagOption.Group == "" {
+			continue
+		}
+
+		i, ok := index[opt.TagOption.Group]
+		if !ok {
+			i = len(groups)
+			index[opt.TagOption.Group] = i
+			groups = append(groups, templateGroup{SetterName: opt.GroupSetterName})
+		}
+
+		groups[i].Options = append(groups[i].Options, opt)
+	}
+
+	for i := range groups {
+		groups[i].SetterDoc = groupSetterDoc(groups[i])
+	}
+
+	return groups
+}
+
+// makeTemplatePresets checks that presets set only known options and
+// instantiate generic options.
+func makeTemplatePresets(presets []Preset, options []templateOptionMeta, generic bool) ([]Preset, error) {
+	fields := make(map[string]struct{}, len(options))
+	for _, opt := range options {
+		fields[opt.Field] = struct{}{}
+	}
+
+	res := make([]Preset, 0, len(presets))
+	for _, preset := range presets {
+		if generic != (preset.TypeArgs != "") {
+			return nil, fmt.Errorf("preset `%s`: type arguments do not match type parameters of options", preset.VarName)
+		}
+
+		for _, field := range preset.Fields {
+			if _, ok := fields[field]; !ok {
+				return nil, fmt.Errorf("preset `%s`: unknown option `%s`", preset.VarName, field)
+			}
+		}
+
+		if preset.Doc == "" {
+			preset.Doc = "// " + preset.Name + " applies the " + preset.VarName + " preset."
+		}
+
+		res = append(res, preset)
+	}
+
+	return res, nil
 }
 
-func makeTemplateOptions(options []OptionMeta) []templateOptionMeta {
-	res := make([]templateOptionMeta, 0, len(options))
+// checkSetterNames checks that setters, aliases and group setters do not
+// have the same names.
+func checkSetterNames(options []templateOptionMeta) error {
+	owners := make(map[string]string, len(options))
+	add := func(name, owner string) error {
+		if other, ok := owners[name]; ok {
+			return fmt.Errorf("%s: setter `%s` conflicts with the setter of %s", owner, name, other)
+		}
+
+		owners[name] = owner
+
+		return nil
+	}
+
+	groups := make(map[string]struct{})
 	for _, opt := range options {
+		owner := "field `" + opt.Field + "`"
+		if opt.HasSetter() {
+			if err := add(opt.SetterName, owner); err != nil {
+				return err
+			}
+		}
+
+		for _, alias := range opt.AliasNames {
+			if err := add(alias, owner+" alias"); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := groups[opt.TagOption.Group]; ok || opt.TagOption.Group == "" {
+			continue
+		}
+
+		groups[opt.TagOption.Group] = struct{}{}
+		if err := add(opt.GroupSetterName, "group `"+opt.TagOption.Group+"` of field `"+opt.Field+"`"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkGroup(opt OptionMeta) error {
+	if opt.TagOption.Group == "" {
+		if opt.TagOption.Standalone {
+			return fmt.Errorf("field `%s`: standalone setter is allowed only for grouped options", opt.Field)
+		}
+
+		return nil
+	}
+
+	if opt.TagOption.IsRequired {
+		return fmt.Errorf("field `%s`: mandatory option cannot be in a group", opt.Field)
+	}
+
+	if !setterNamePattern.MatchString(opt.TagOption.Group) {
+		return fmt.Errorf("field `%s`: invalid group `%s`", opt.Field, opt.TagOption.Group)
+	}
+
+	if len(opt.TagOption.Aliases) != 0 && !opt.TagOption.Standalone {
+		return fmt.Errorf("field `%s`: grouped option can have an alias only with the standalone setter", opt.Field)
+	}
+
+	return nil
+}
+
+func makeTemplateOptions(opts Options) []templateOptionMeta {
+	initialisms := opts.initialismsSet()
+
+	// NOTE: builder methods do not need the prefix, which is used to avoid
+	// conflicts of functions in the package.
+	setterPrefix := opts.setterPrefix + opts.prefix
+	if opts.style == StyleBuilder {
+		setterPrefix = opts.setterPrefix
+	}
+
+	res := make([]templateOptionMeta, 0, len(opts.spec.Options))
+	for _, opt := range opts.spec.Options {
 		targetName := opt.Name
 		targetField := opt.Field
 		if opt.TagOption.Name != "" {
 			targetName = opt.TagOption.Name
 			targetField = opt.TagOption.Name
+		} else if opts.naming == NamingGo {
+			targetName = goName(opt.Name, initialisms)
+		}
+
+		parseExpr, errParse := valueParseExpr(opt.ParseType(), "raw")
+
+		// NOTE: flags are registered only for options that can be parsed from
+		// the string and could be set by setter.
+		var flagName, flagUsage string
+		if opts.withFlags && errParse == nil && !opt.TagOption.IsRequired && !opt.TagOption.Variadic {
+			flagName = kebabCase(opt.Field)
+			flagUsage = commentText(opt.Docstring)
+		}
+
+		stringExpr, logValueExpr := stringerExprs(opt)
+
+		aliasNames := make([]string, 0, len(opt.TagOption.Aliases))
+		for _, alias := range opt.TagOption.Aliases {
+			aliasNames = append(aliasNames, setterPrefix+alias)
+		}
+
+		var groupSetterName string
+		if opt.TagOption.Group != "" {
+			groupSetterName = setterPrefix + opt.TagOption.Group
 		}
 
 		res = append(res, templateOptionMeta{
-			OptionMeta:  opt,
-			TargetName:  targetName,
-			TargetField: targetField,
+			OptionMeta:      opt,
+			TargetName:      targetName,
+			TargetField:     targetField,
+			SetterName:      setterPrefix + targetName,
+			AliasNames:      aliasNames,
+			GroupSetterName: groupSetterName,
+			ParseExpr:       parseExpr,
+			FlagName:        flagName,
+			FlagUsage:       flagUsage,
+			StringExpr:      stringExpr,
+			LogValueExpr:    logValueExpr,
+			Doc:             commentText(opt.Docstring),
+			SpecDefault:     specDefault(opt, opts),
 		})
 	}
 
 	return res
 }
 
+// specDefault returns the default value of the option for the -with-spec
+// description. Defaults of the variable or function are described by the
+// expression, which reads them.
+func specDefault(opt OptionMeta, opts Options) string {
+	switch {
+	case opt.TagOption.IsRequired:
+		return ""
+	case opts.varName != "":
+		return opts.varName + "." + opt.Field
+	case opts.funcName != "":
+		return opts.funcName + "()." + opt.Field
+	default:
+		return opt.TagOption.Default
+	}
+}
+
 type GetOptionSpecRes struct {
 	Spec     OptionSpec
 	Warnings []string
@@ -228,18 +598,55 @@ func GetOptionSpec(
 	workDir := path.Dir(filePath)
 	fset := token.NewFileSet()
 
+	// NOTE: the syntax is used only for structs of other packages, like
+	// `type Options pkg.Options`.
+	typed, typeSpec, structType, err := findTypedStruct(fset, filePath, optStructName)
+	if err == nil {
+		return getOptionSpec(fset, workDir, typed.file, typed,
+			extractFields(typeSpec.TypeParams), extractFields(structType.Fields), tagName, allVariadic, excludes)
+	}
+
+	if !errors.Is(err, errForeignStruct) {
+		return nil, err
+	}
+
+	fset = token.NewFileSet()
+
 	file, typeParams, fields, err := findStructTypeParamsAndFields(fset, filePath, optStructName)
 	if err != nil {
 		return nil, fmt.Errorf("cannot find target struct: %w", err)
 	}
 
+	return getOptionSpec(fset, workDir, file, nil, typeParams, fields, tagName, allVariadic, excludes)
+}
+
+// getOptionSpec scans fields of the options struct declared in file. Types
+// of fields are resolved by typed, which is nil when the file is not
+// type-checked.
+func getOptionSpec(
+	fset *token.FileSet,
+	workDir string,
+	file *ast.File,
+	typed *typedFile,
+	typeParams, fields []*ast.Field,
+	tagName string,
+	allVariadic bool,
+	excludes []*regexp.Regexp,
+) (*GetOptionSpecRes, error) {
 	options := make([]OptionMeta, 0, len(fields))
 	packageStore := NewPackageStore(fset, workDir)
 
 	var warnings []string
+	var sourcesField string
 	for idx := range fields {
 		field := fields[idx]
 
+		if len(field.Names) > 0 && isSourcesType(file, field.Type) {
+			sourcesField = field.Names[0].Name
+
+			continue
+		}
+
 		var fieldName string
 		if len(field.Names) > 0 {
 			fieldName = field.Names[0].Name
@@ -266,8 +673,10 @@ func GetOptionSpec(
 			Name:      cases.Title(language.English, cases.NoLower).String(fieldName),
 			Docstring: formatComment(field.Doc.Text()),
 			Field:     fieldName,
-			Type:      types.ExprString(field.Type),
+			Type:      typed.typeString(field.Type),
 			TagOption: tagOption,
+			ValueType: valueType(typed.typeOf(field.Type), typed.typeString(field.Type)),
+			Kind:      typeKind(typed.typeOf(field.Type)),
 		}
 
 		if optMeta.TagOption.Default != "" {
@@ -275,11 +684,39 @@ func GetOptionSpec(
 				return nil, fmt.Errorf("field `%s`: mandatory option cannot have a default value", optMeta.Field)
 			}
 
-			if err := checkDefaultValue(optMeta.Type, optMeta.TagOption.Default); err != nil {
+			if err := checkDefaultValue(optMeta.ParseType(), optMeta.TagOption.Default); err != nil {
 				return nil, fmt.Errorf("field `%s`: invalid `%s` tag value: %w", tagName, optMeta.Field, err)
 			}
 		}
 
+		if err := checkGroup(optMeta); err != nil {
+			return nil, err
+		}
+
+		for _, alias := range optMeta.TagOption.Aliases {
+			if optMeta.TagOption.IsRequired {
+				return nil, fmt.Errorf("field `%s`: mandatory option cannot have an alias", optMeta.Field)
+			}
+
+			if !setterNamePattern.MatchString(alias) {
+				return nil, fmt.Errorf("field `%s`: invalid alias `%s`", optMeta.Field, alias)
+			}
+		}
+
+		if optMeta.TagOption.Env != "" {
+			if optMeta.TagOption.IsRequired {
+				return nil, fmt.Errorf("field `%s`: mandatory option cannot be read from env", optMeta.Field)
+			}
+
+			if optMeta.TagOption.Variadic {
+				return nil, fmt.Errorf("field `%s`: variadic option cannot be read from env", optMeta.Field)
+			}
+
+			if _, err := valueParseExpr(optMeta.ParseType(), "raw"); err != nil {
+				return nil, fmt.Errorf("field `%s`: invalid `env` tag: %w", optMeta.Field, err)
+			}
+		}
+
 		if optMeta.TagOption.Variadic || allVariadic { //nolint:nestif
 			if optMeta.TagOption.IsRequired {
 				if optMeta.TagOption.Variadic {
@@ -291,7 +728,7 @@ func GetOptionSpec(
 				continue
 			}
 
-			elementType, err := extractSliceElemType(file, field.Type, packageStore)
+			elementType, err := sliceElemType(file, typed, field.Type, packageStore)
 			if err != nil {
 				if errors.Is(err, errIsNotSlice) && !optMeta.TagOption.Variadic {
 					options = append(options, optMeta)
@@ -308,6 +745,14 @@ func GetOptionSpec(
 
 			if optMeta.TagOption.Variadic {
 				optMeta.Type = elementType
+				optMeta.ValueType, optMeta.Kind = "", KindUnknown
+
+				if typ := typed.typeOf(field.Type); typ != nil {
+					if slice, ok := typ.Underlying().(*types.Slice); ok && isValidType(slice.Elem()) {
+						optMeta.ValueType = valueType(slice.Elem(), elementType)
+						optMeta.Kind = typeKind(slice.Elem())
+					}
+				}
 			}
 		}
 
@@ -338,6 +783,7 @@ func GetOptionSpec(
 			TypeParamsSpec: tpSpec,
 			TypeParams:     tpString,
 			Options:        ApplyExcludes(options, excludes),
+			SourcesField:   sourcesField,
 		},
 		Warnings: warnings,
 		Imports:  importSlice,
@@ -39,6 +39,7 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 					Docstring: "// stringer bla-bla",
 					Field:     "stringer",
 					Type:      "fmt.Stringer",
+					Kind:      generator.KindInterface,
 					TagOption: generator.TagOption{
 						IsRequired:    true,
 						GoValidator:   "required",
@@ -47,6 +48,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -62,6 +66,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -69,6 +76,7 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 					Docstring: "",
 					Field:     "someMap",
 					Type:      "map[string]string",
+					Kind:      generator.KindMap,
 					TagOption: generator.TagOption{
 						IsRequired:    true,
 						GoValidator:   "required",
@@ -77,6 +85,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -91,6 +102,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -105,6 +119,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -120,6 +137,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -127,6 +147,7 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 					Docstring: "",
 					Field:     "starOpt",
 					Type:      "*int",
+					Kind:      generator.KindPointer,
 					TagOption: generator.TagOption{
 						IsRequired:    true,
 						GoValidator:   "",
@@ -135,6 +156,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -142,6 +166,7 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 					Docstring: "",
 					Field:     "sliceOpt",
 					Type:      "[]int",
+					Kind:      generator.KindSlice,
 					TagOption: generator.TagOption{
 						IsRequired:    true,
 						GoValidator:   "",
@@ -150,6 +175,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -165,6 +193,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: true,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -180,6 +211,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -195,6 +229,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -210,6 +247,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -225,6 +265,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -240,6 +283,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -254,6 +300,9 @@ func TestGetOptionSpec(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -298,6 +347,9 @@ func TestGetOptionSpec_Generics(t *testing.T) {
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -313,6 +365,9 @@ func TestGetOptionSpec_Generics(t *testing.T) {
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -328,6 +383,9 @@ func TestGetOptionSpec_Generics(t *testing.T) {
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -343,6 +401,9 @@ func TestGetOptionSpec_Generics(t *testing.T) {
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -451,6 +512,9 @@ func TestGetOptionSpecInline(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -483,6 +547,7 @@ func TestGetOptionSpecInlinePtr(t *testing.T) { //nolint:funlen
 					Name:      "InlineStruct",
 					Field:     "InlineStruct",
 					Type:      "*struct{Field1 string}",
+					Kind:      generator.KindPointer,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -492,6 +557,9 @@ func TestGetOptionSpecInlinePtr(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -533,6 +601,9 @@ func TestGetOptionSpecEmbed(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -565,6 +636,7 @@ func TestGetOptionSpecEmbedPtr(t *testing.T) { //nolint:funlen
 					Name:      "EmbedStruct",
 					Field:     "EmbedStruct",
 					Type:      "*EmbedStruct",
+					Kind:      generator.KindPointer,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -574,6 +646,9 @@ func TestGetOptionSpecEmbedPtr(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -615,6 +690,9 @@ func TestGetOptionSpecEmbedAnotherPkg(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -647,6 +725,7 @@ func TestGetOptionSpecEmbedAnotherPkgPtr(t *testing.T) { //nolint:funlen
 					Name:      "StructForEmbed",
 					Field:     "StructForEmbed",
 					Type:      "*testdata.StructForEmbed",
+					Kind:      generator.KindPointer,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -656,6 +735,9 @@ func TestGetOptionSpecEmbedAnotherPkgPtr(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
@@ -715,12 +797,16 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
 					Name:      "IntsPtr",
 					Field:     "intsPtr",
 					Type:      "*int",
+					Kind:      generator.KindPointer,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -730,6 +816,9 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -745,12 +834,16 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
 					Name:      "StructsPtr",
 					Field:     "structsPtr",
 					Type:      "*testdata.StructForEmbed",
+					Kind:      generator.KindPointer,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -760,6 +853,9 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -775,6 +871,9 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
@@ -790,12 +889,16 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
 					Name:      "RefUUIDs",
 					Field:     "refUUIDs",
 					Type:      "validator.FieldError",
+					Kind:      generator.KindInterface,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -805,12 +908,16 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 				{
 					Name:      "RefUUIDs2",
 					Field:     "refUUIDs2",
 					Type:      "validator.FieldError",
+					Kind:      generator.KindInterface,
 					Docstring: "",
 					TagOption: generator.TagOption{
 						IsRequired:    false,
@@ -820,6 +927,9 @@ func TestGetOptionSpecSliceAlice(t *testing.T) { //nolint:funlen
 						VariadicIsSet: false,
 						Skip:          false,
 						Name:          "",
+						Env:           "",
+						Key:           "",
+						Secret:        false,
 					},
 				},
 			},
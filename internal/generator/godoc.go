@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"strings"
+)
+
+// setterDoc returns the doc comment for the setter. Besides the field comment
+// it describes the default value, validation, the IsSet constant and the
+// deprecation notice.
+func setterDoc(opt templateOptionMeta, opts Options) string {
+	var details []string
+
+	if defaultDoc := optionDefaultDoc(opt.OptionMeta, opts); defaultDoc != "" {
+		details = append(details, defaultDoc)
+	}
+
+	if opt.TagOption.GoValidator != "" {
+		details = append(details, "Validation: "+opt.TagOption.GoValidator+".")
+	}
+
+	if opt.TagOption.Variadic {
+		details = append(details, "Variadic: values are appended to the current ones.")
+	}
+
+	if opts.withIsset {
+		details = append(details, "Check: IsSet(Field"+opts.prefix+opt.Field+").")
+	}
+
+	if len(details) == 0 && opt.TagOption.Deprecated == "" {
+		return opt.Docstring
+	}
+
+	// NOTE: indented lines followed by details would be formatted as a code
+	// block, so the indentation is removed.
+	var lines []string
+	for _, line := range strings.Split(opt.Docstring, "\n") {
+		if line = strings.TrimSpace(strings.TrimPrefix(line, "//")); line != "" {
+			lines = append(lines, "// "+line)
+		} else if len(lines) != 0 {
+			lines = append(lines, "//")
+		}
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "// "+opt.SetterName+" sets "+opt.Field+".")
+	}
+
+	doc := strings.Join(lines, "\n")
+	if len(details) != 0 {
+		doc += "\n//\n// " + strings.Join(details, "\n// ")
+	}
+
+	// NOTE: deprecation notice should be a separate paragraph.
+	if opt.TagOption.Deprecated != "" {
+		doc += "\n//\n// Deprecated: " + opt.TagOption.Deprecated
+	}
+
+	return doc
+}
+
+func optionDefaultDoc(opt OptionMeta, opts Options) string {
+	switch {
+	case opts.varName != "":
+		return "Default: " + opts.varName + "." + opt.Field + "."
+	case opts.funcName != "":
+		return "Default: " + opts.funcName + "()." + opt.Field + "."
+	case opt.TagOption.Default == "":
+		return ""
+	case opts.defaultsFile != nil:
+		return "Default: " + opt.TagOption.Default + " (from " + opts.defaultsFile.Path + ")."
+	default:
+		return "Default: " + opt.TagOption.Default + " (from tag)."
+	}
+}
+
+// constructorDoc returns the doc comment for the constructor, which lists the
+// mandatory options.
+func constructorDoc(name string, options []templateOptionMeta, opts Options) string {
+	hasDefaults := opts.varName != "" || opts.funcName != ""
+
+	var mandatory []string
+	for _, opt := range options {
+		if opt.TagOption.Default != "" {
+			hasDefaults = true
+		}
+
+		if !opt.TagOption.IsRequired {
+			continue
+		}
+
+		item := "//   - " + opt.TargetField
+		if opt.Doc != "" {
+			item += ": " + opt.Doc
+		}
+
+		mandatory = append(mandatory, item)
+	}
+
+	var steps []string
+	if hasDefaults {
+		steps = append(steps, "the defaults")
+	}
+
+	if len(mandatory) != 0 {
+		steps = append(steps, "the mandatory options")
+	}
+
+	summary := name + " creates " + opts.optionsStructName + " and applies the setters."
+	switch {
+	case opts.style == StyleBuilder && len(steps) != 0:
+		summary = name + " creates the builder of " + opts.optionsStructName + " and applies " +
+			strings.Join(steps, " and ") + "."
+	case opts.style == StyleBuilder:
+		summary = name + " creates the builder of " + opts.optionsStructName + "."
+	case len(steps) != 0:
+		summary = name + " creates " + opts.optionsStructName + ": applies " +
+			strings.Join(steps, ", ") + " and then the setters."
+	}
+
+	lines := wrapComment(summary)
+	if len(mandatory) != 0 {
+		lines = append(lines, "//", "// Mandatory options:")
+		lines = append(lines, mandatory...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// groupSetterDoc returns the doc comment for the setter of the group, which
+// lists the options of the group.
+func groupSetterDoc(group templateGroup) string {
+	fields := make([]string, 0, len(group.Options))
+	var items []string
+	for _, opt := range group.Options {
+		fields = append(fields, opt.TargetField)
+		if opt.Doc != "" {
+			items = append(items, "//   - "+opt.TargetField+": "+opt.Doc)
+		}
+	}
+
+	summary := group.SetterName + " sets " + strings.Join(fields, ", ") + " at once."
+	if len(fields) > 1 {
+		summary = group.SetterName + " sets " + strings.Join(fields[:len(fields)-1], ", ") +
+			" and " + fields[len(fields)-1] + " at once."
+	}
+
+	lines := wrapComment(summary)
+	if len(items) != 0 {
+		lines = append(lines, "//")
+		lines = append(lines, items...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wrapComment splits the text into comment lines of a reasonable length.
+func wrapComment(text string) []string {
+	const maxLineLen = 80
+
+	var lines []string
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if line != "//" && len(line)+1+len(word) > maxLineLen {
+			lines = append(lines, line)
+			line = "//"
+		}
+
+		line += " " + word
+	}
+
+	return append(lines, line)
+}
@@ -0,0 +1,123 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_setterDoc(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions(WithPrefix("Client"), WithWithIsset(true))
+
+	t.Run("no_details", func(t *testing.T) {
+		t.Parallel()
+
+		opt := templateOptionMeta{OptionMeta: OptionMeta{Field: "name", Docstring: "// Name of the client."}}
+		assert.Equal(t, "// Name of the client.", setterDoc(opt, NewOptions()))
+	})
+
+	t.Run("details", func(t *testing.T) {
+		t.Parallel()
+
+		opt := templateOptionMeta{
+			OptionMeta: OptionMeta{
+				Field:     "labels",
+				Docstring: "// \tLabels\n// \tof the client.",
+				TagOption: TagOption{Default: "a", GoValidator: "min=1", Variadic: true},
+			},
+			TargetName: "Labels",
+		}
+		assert.Equal(t, `// Labels
+// of the client.
+//
+// Default: a (from tag).
+// Validation: min=1.
+// Variadic: values are appended to the current ones.
+// Check: IsSet(FieldClientlabels).`, setterDoc(opt, opts))
+	})
+
+	t.Run("without_comment", func(t *testing.T) {
+		t.Parallel()
+
+		opt := templateOptionMeta{
+			OptionMeta: OptionMeta{Field: "timeout"},
+			TargetName: "Timeout",
+			SetterName: "WithClientTimeout",
+		}
+		assert.Equal(t, "// WithClientTimeout sets timeout.\n//\n// Default: defaultOptions.timeout.",
+			setterDoc(opt, NewOptions(WithPrefix("Client"), WithVarName("defaultOptions"))))
+	})
+}
+
+func Test_constructorDoc(t *testing.T) {
+	t.Parallel()
+
+	options := []templateOptionMeta{
+		{OptionMeta: OptionMeta{Field: "addr", TagOption: TagOption{IsRequired: true}}, TargetField: "addr", Doc: "Address."},
+		{OptionMeta: OptionMeta{Field: "tls", TagOption: TagOption{IsRequired: true}}, TargetField: "TLS"},
+		{OptionMeta: OptionMeta{Field: "timeout", TagOption: TagOption{Default: "3s"}}},
+	}
+
+	assert.Equal(t, `// NewOptions creates Options: applies the defaults, the mandatory options and
+// then the setters.
+//
+// Mandatory options:
+//   - addr: Address.
+//   - TLS`, constructorDoc("NewOptions", options, NewOptions(WithOptionsStructName("Options"))))
+
+	assert.Equal(t, "// newOptions creates Options and applies the setters.",
+		constructorDoc("newOptions", nil, NewOptions(WithOptionsStructName("Options"))))
+}
+
+func Test_setterDoc_deprecated(t *testing.T) {
+	t.Parallel()
+
+	opt := templateOptionMeta{
+		OptionMeta: OptionMeta{
+			Field:     "retries",
+			Docstring: "// Number of retries.",
+			TagOption: TagOption{Deprecated: "use WithPolicy instead."},
+		},
+		SetterName: "WithRetries",
+	}
+	assert.Equal(t, "// Number of retries.\n//\n// Deprecated: use WithPolicy instead.", setterDoc(opt, NewOptions()))
+}
+
+func Test_groupSetterDoc(t *testing.T) {
+	t.Parallel()
+
+	group := templateGroup{
+		SetterName: "WithTLS",
+		Options: []templateOptionMeta{
+			{TargetField: "certFile", Doc: "Path to the certificate."},
+			{TargetField: "keyFile"},
+		},
+	}
+	assert.Equal(t, `// WithTLS sets certFile and keyFile at once.
+//
+//   - certFile: Path to the certificate.`, groupSetterDoc(group))
+
+	group.Options = group.Options[1:]
+	assert.Equal(t, "// WithTLS sets keyFile at once.", groupSetterDoc(group))
+}
+
+func Test_constructorDoc_builder(t *testing.T) {
+	t.Parallel()
+
+	options := []templateOptionMeta{
+		{OptionMeta: OptionMeta{Field: "addr", TagOption: TagOption{IsRequired: true}}, TargetField: "addr"},
+	}
+
+	assert.Equal(t, `// NewOptionsBuilder creates the builder of Options and applies the mandatory
+// options.
+//
+// Mandatory options:
+//   - addr`, constructorDoc("NewOptionsBuilder", options,
+		NewOptions(WithOptionsStructName("Options"), WithStyle(StyleBuilder))))
+
+	assert.Equal(t, "// NewOptionsBuilder creates the builder of Options.",
+		constructorDoc("NewOptionsBuilder", nil, NewOptions(WithOptionsStructName("Options"), WithStyle(StyleBuilder))))
+}
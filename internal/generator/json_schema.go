@@ -0,0 +1,249 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Default              any                    `json:"default,omitempty"`
+	Minimum              json.Number            `json:"minimum,omitempty"`
+	Maximum              json.Number            `json:"maximum,omitempty"`
+	ExclusiveMinimum     json.Number            `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     json.Number            `json:"exclusiveMaximum,omitempty"`
+	MinLength            json.Number            `json:"minLength,omitempty"`
+	MaxLength            json.Number            `json:"maxLength,omitempty"`
+	MinItems             json.Number            `json:"minItems,omitempty"`
+	MaxItems             json.Number            `json:"maxItems,omitempty"`
+	MinProperties        json.Number            `json:"minProperties,omitempty"`
+	MaxProperties        json.Number            `json:"maxProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+}
+
+// jsonSchemaFormats maps go-playground/validator rules to JSON Schema formats.
+var jsonSchemaFormats = map[string]string{
+	"url":      "uri",
+	"uri":      "uri",
+	"email":    "email",
+	"uuid":     "uuid",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+}
+
+// RenderJSONSchema will render JSON Schema that describes the options as an
+// object. Properties are named the same way as in decode functions (see
+// OptionMeta.DecodeKey). It returns warnings for the options and validation
+// rules, which can not be expressed by JSON Schema.
+func RenderJSONSchema(opts Options) ([]byte, []string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("bad configuration: %w", err)
+	}
+
+	var warnings []string
+
+	root := &jsonSchema{
+		Schema:     jsonSchemaDraft,
+		Title:      opts.optionsStructName,
+		Type:       "object",
+		Properties: make(map[string]*jsonSchema, len(opts.spec.Options)),
+	}
+
+	for _, opt := range makeTemplateOptions(opts) {
+		fieldType := opt.ParseType()
+		if opt.TagOption.Variadic {
+			fieldType = "[]" + fieldType
+		}
+
+		key := opt.DecodeKey()
+		warn := func(format string, args ...any) {
+			warnings = append(warnings,
+				fmt.Sprintf("Warning: JSON Schema: field `%s`: ", opt.Field)+fmt.Sprintf(format, args...))
+		}
+
+		schema, ok := jsonSchemaForType(fieldType)
+		if !ok {
+			warn("type `%s` is not supported, any value is allowed", fieldType)
+		}
+
+		schema.Description = opt.Doc
+
+		if opt.TagOption.Default != "" {
+			schema.Default = jsonSchemaValue(fieldType, opt.TagOption.Default)
+		}
+
+		required := opt.TagOption.IsRequired
+		for _, rule := range splitValidationRules(opt.TagOption.GoValidator) {
+			if rule == "required" {
+				required = true
+
+				continue
+			}
+
+			// NOTE: rules after dive are applied to the elements.
+			if rule == "dive" {
+				warn("validation rules for elements (`dive`) are not supported")
+
+				break
+			}
+
+			if !applyJSONSchemaRule(schema, fieldType, rule) {
+				warn("validation rule `%s` is not supported", rule)
+			}
+		}
+
+		if required {
+			root.Required = append(root.Required, key)
+		}
+
+		root.Properties[key] = schema
+	}
+
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot marshal JSON Schema: %w", err)
+	}
+
+	return append(data, '\n'), warnings, nil
+}
+
+func jsonSchemaForType(fieldType string) (*jsonSchema, bool) {
+	switch {
+	case fieldType == "string", fieldType == "time.Duration":
+		return &jsonSchema{Type: "string"}, true
+	case fieldType == "bool":
+		return &jsonSchema{Type: "boolean"}, true
+	case fieldType == "uint", fieldType == "uint8", fieldType == "uint16", fieldType == "uint32", fieldType == "uint64":
+		return &jsonSchema{Type: "integer", Minimum: "0"}, true
+	case fieldType == "int", fieldType == "int8", fieldType == "int16", fieldType == "int32", fieldType == "int64":
+		return &jsonSchema{Type: "integer"}, true
+	case fieldType == "float32", fieldType == "float64":
+		return &jsonSchema{Type: "number"}, true
+	case strings.HasPrefix(fieldType, "*"):
+		return jsonSchemaForType(fieldType[1:])
+	case strings.HasPrefix(fieldType, "[]"):
+		items, ok := jsonSchemaForType(fieldType[2:])
+
+		return &jsonSchema{Type: "array", Items: items}, ok
+	case strings.HasPrefix(fieldType, "map[string]"):
+		values, ok := jsonSchemaForType(strings.TrimPrefix(fieldType, "map[string]"))
+
+		return &jsonSchema{Type: "object", AdditionalProperties: values}, ok
+	}
+
+	return &jsonSchema{}, false
+}
+
+// jsonSchemaValue converts the value to the JSON type of the field. Values
+// were checked already, so the raw value is returned on parse errors.
+func jsonSchemaValue(fieldType, value string) any {
+	switch jsonType, _ := jsonSchemaForType(fieldType); jsonType.Type {
+	case "boolean":
+		if res, err := strconv.ParseBool(value); err == nil {
+			return res
+		}
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	}
+
+	return value
+}
+
+func splitValidationRules(rules string) []string {
+	if rules == "" {
+		return nil
+	}
+
+	return strings.Split(rules, ",")
+}
+
+// applyJSONSchemaRule translates single validation rule into the schema
+// keywords. Returns false when the rule is not supported.
+func applyJSONSchemaRule(schema *jsonSchema, fieldType, rule string) bool {
+	name, param, _ := strings.Cut(rule, "=")
+	if name == "omitempty" {
+		return true
+	}
+
+	if format, ok := jsonSchemaFormats[name]; ok && param == "" && schema.Type == "string" {
+		schema.Format = format
+
+		return true
+	}
+
+	if name == "oneof" && param != "" {
+		for _, value := range strings.Fields(param) {
+			schema.Enum = append(schema.Enum, jsonSchemaValue(fieldType, value))
+		}
+
+		return true
+	}
+
+	if _, err := strconv.ParseFloat(param, 64); err != nil {
+		return false
+	}
+
+	number := json.Number(param)
+
+	switch schema.Type {
+	case "integer", "number":
+		switch name {
+		case "min", "gte":
+			schema.Minimum = number
+		case "max", "lte":
+			schema.Maximum = number
+		case "gt":
+			schema.ExclusiveMinimum = number
+		case "lt":
+			schema.ExclusiveMaximum = number
+		case "len", "eq":
+			schema.Minimum, schema.Maximum = number, number
+		default:
+			return false
+		}
+
+		return true
+	case "string":
+		if fieldType == "time.Duration" {
+			return false
+		}
+
+		return applyJSONSchemaLenRule(name, number, &schema.MinLength, &schema.MaxLength)
+	case "array":
+		return applyJSONSchemaLenRule(name, number, &schema.MinItems, &schema.MaxItems)
+	case "object":
+		return applyJSONSchemaLenRule(name, number, &schema.MinProperties, &schema.MaxProperties)
+	}
+
+	return false
+}
+
+func applyJSONSchemaLenRule(name string, number json.Number, minValue, maxValue *json.Number) bool {
+	switch name {
+	case "min", "gte":
+		*minValue = number
+	case "max", "lte":
+		*maxValue = number
+	case "len":
+		*minValue, *maxValue = number, number
+	default:
+		return false
+	}
+
+	return true
+}
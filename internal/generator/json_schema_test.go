@@ -0,0 +1,82 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions(
+		WithVersion("test"),
+		WithPackageName("test"),
+		WithOptionsStructName("Options"),
+		WithOptionTypeName("Option"),
+		WithConstructorTypeRender("public"),
+		WithSpec(&OptionSpec{Options: []OptionMeta{
+			{Field: "addr", Type: "string", TagOption: TagOption{IsRequired: true, GoValidator: "hostname_port"}},
+			{Field: "maxRetries", Type: "uint", TagOption: TagOption{Default: "3", GoValidator: "max=10"}},
+			{Field: "labels", Type: "string", TagOption: TagOption{Variadic: true, GoValidator: "min=1,dive,required"}},
+			{Field: "handler", Type: "func()"},
+		}}),
+	)
+
+	data, warnings, err := RenderJSONSchema(opts)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Warning: JSON Schema: field `addr`: validation rule `hostname_port` is not supported",
+		"Warning: JSON Schema: field `labels`: validation rules for elements (`dive`) are not supported",
+		"Warning: JSON Schema: field `handler`: type `func()` is not supported, any value is allowed",
+	}, warnings)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, []any{"addr"}, schema["required"])
+	require.Equal(t, map[string]any{
+		"addr":        map[string]any{"type": "string"},
+		"max_retries": map[string]any{"type": "integer", "minimum": 0.0, "maximum": 10.0, "default": 3.0},
+		"labels": map[string]any{
+			"type":     "array",
+			"minItems": 1.0,
+			"items":    map[string]any{"type": "string"},
+		},
+		"handler": map[string]any{},
+	}, schema["properties"])
+}
+
+func Test_applyJSONSchemaRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		fieldType string
+		rule      string
+		want      *jsonSchema
+		wantOk    bool
+	}{
+		{name: "int_min", fieldType: "int", rule: "min=1", want: &jsonSchema{Type: "integer", Minimum: "1"}, wantOk: true},
+		{name: "float_lt", fieldType: "float64", rule: "lt=0.5", want: &jsonSchema{Type: "number", ExclusiveMaximum: "0.5"}, wantOk: true},
+		{name: "string_len", fieldType: "string", rule: "len=2", want: &jsonSchema{Type: "string", MinLength: "2", MaxLength: "2"}, wantOk: true},
+		{name: "map_max", fieldType: "map[string]int", rule: "max=3", want: &jsonSchema{Type: "object", MaxProperties: "3", AdditionalProperties: &jsonSchema{Type: "integer"}}, wantOk: true},
+		{name: "url", fieldType: "string", rule: "url", want: &jsonSchema{Type: "string", Format: "uri"}, wantOk: true},
+		{name: "oneof_int", fieldType: "int", rule: "oneof=1 2", want: &jsonSchema{Type: "integer", Enum: []any{json.Number("1"), json.Number("2")}}, wantOk: true},
+		{name: "url_for_int", fieldType: "int", rule: "url", want: &jsonSchema{Type: "integer"}, wantOk: false},
+		{name: "duration_min", fieldType: "time.Duration", rule: "min=1s", want: &jsonSchema{Type: "string"}, wantOk: false},
+		{name: "unknown", fieldType: "string", rule: "alphanum", want: &jsonSchema{Type: "string"}, wantOk: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, _ := jsonSchemaForType(tc.fieldType)
+			ok := applyJSONSchemaRule(schema, tc.fieldType, tc.rule)
+			require.Equal(t, tc.wantOk, ok)
+			require.Equal(t, tc.want, schema)
+		})
+	}
+}
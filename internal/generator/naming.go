@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Naming is a strategy to build setter names from field names.
+type Naming string
+
+const (
+	// NamingTitle capitalizes the first letter: httpClient => HttpClient.
+	NamingTitle Naming = "title"
+	// NamingGo follows Go conventions for initialisms: httpClient => HTTPClient.
+	NamingGo Naming = "go"
+)
+
+// commonInitialisms is the list from golint.
+var commonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON",
+	"LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID",
+	"UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+func (o *Options) initialismsSet() map[string]struct{} {
+	res := make(map[string]struct{}, len(commonInitialisms)+len(o.initialisms))
+	for _, initialism := range commonInitialisms {
+		res[initialism] = struct{}{}
+	}
+
+	for _, initialism := range o.initialisms {
+		res[strings.ToUpper(initialism)] = struct{}{}
+	}
+
+	return res
+}
+
+// goName converts name to the exported Go name. Words that are known
+// initialisms are upper-cased: tlsConfig => TLSConfig, userId => UserID.
+func goName(name string, initialisms map[string]struct{}) string {
+	var buf strings.Builder
+	for _, word := range splitWords(name) {
+		if _, ok := initialisms[strings.ToUpper(word)]; ok {
+			buf.WriteString(strings.ToUpper(word))
+
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		buf.WriteString(string(runes))
+	}
+
+	return buf.String()
+}
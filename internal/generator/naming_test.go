@@ -0,0 +1,31 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_goName(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions(WithInitialisms([]string{"grpc", "K8S"}))
+	initialisms := opts.initialismsSet()
+
+	tests := map[string]string{
+		"Url":        "URL",
+		"HttpClient": "HTTPClient",
+		"TlsConfig":  "TLSConfig",
+		"UserId":     "UserID",
+		"ServerURL":  "ServerURL",
+		"GrpcAddr":   "GRPCAddr",
+		"K8sConfig":  "K8SConfig",
+		"Idle":       "Idle",
+		"Timeout":    "Timeout",
+		"Api_key":    "APIKey",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, goName(in, initialisms), in)
+	}
+}
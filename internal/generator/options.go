@@ -1,6 +1,6 @@
 package generator
 
-//go:generate toolset run options-gen -from-struct=Options
+//go:generate go run ../../cmd/options-gen -from-struct=Options
 type Options struct {
 	version               string `validate:"required"`
 	packageName           string `validate:"required"`
@@ -10,8 +10,25 @@ type Options struct {
 	tagName               string
 	varName               string
 	funcName              string
+	defaultsFile          *DefaultsFile
 	prefix                string
 	withIsset             bool
+	envPrefix             string
+	withFlags             bool
+	withDecode            bool
+	decodeStrict          bool
+	withStringer          bool
+	withSpec              bool
+	withSource            bool
 	constructorTypeRender string `validate:"required"`
 	optionTypeName        string `validate:"required"`
+	naming                Naming `default:"title"`
+	initialisms           []string
+	setterPrefix          string `default:"With"`
+	constructorName       string
+	withCombinators       bool
+	presets               []Preset
+	style                 Style `default:"functional"`
+	funcSpec              *FuncSpec
+	structDecl            *StructDecl
 }
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// presetDirective is not a directive for gofmt because of the dash, so it
+// could be formatted as "// options-gen:preset".
+const presetDirective = "options-gen:preset"
+
+// Preset is a package-level variable annotated with the preset directive:
+//
+//	// options-gen:preset [Name]
+//	var forTesting = Options{timeout: time.Second}
+//
+// The variable is a slice of setters or a partial options value.
+type Preset struct {
+	// Name of the generated setter. By default, it is the variable name with
+	// the first letter in upper case.
+	Name    string
+	VarName string
+	Doc     string
+	// Setters is true when the variable is a slice of setters.
+	Setters bool
+	// Fields are copied from the partial options value.
+	Fields []string
+	// TypeArgs are type arguments of generic options, like [int]. The preset
+	// is generated for this instantiation only.
+	TypeArgs string
+}
+
+// GetPresets finds annotated variables of the package of filePath, which
+// have the type []optionTypeName or structName.
+func GetPresets(filePath, structName, optionTypeName string) ([]Preset, error) {
+	_, files, err := parsePackageFiles(filePath, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var presets []Preset
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec) //nolint:forcetypeassert
+
+				doc := valueSpec.Doc
+				if doc == nil && len(genDecl.Specs) == 1 {
+					doc = genDecl.Doc
+				}
+
+				name, ok := presetName(doc)
+				if !ok {
+					continue
+				}
+
+				preset, ok, err := makePreset(valueSpec, name, presetDoc(doc), structName, optionTypeName)
+				if err != nil {
+					return nil, err
+				}
+
+				if ok {
+					presets = append(presets, preset)
+				}
+			}
+		}
+	}
+
+	return presets, nil
+}
+
+// presetName returns the name from the directive, the name is empty when it
+// is not specified.
+func presetName(doc *ast.CommentGroup) (string, bool) {
+	if doc == nil {
+		return "", false
+	}
+
+	for _, comment := range doc.List {
+		if name, ok := parsePresetDirective(comment.Text); ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+func parsePresetDirective(comment string) (string, bool) {
+	text := strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+	if text == presetDirective {
+		return "", true
+	}
+
+	if name, ok := strings.CutPrefix(text, presetDirective+" "); ok {
+		return strings.TrimSpace(name), true
+	}
+
+	return "", false
+}
+
+// presetDoc returns the doc comment without the directive.
+func presetDoc(doc *ast.CommentGroup) string {
+	var lines []string
+	for _, comment := range doc.List {
+		if _, ok := parsePresetDirective(comment.Text); !ok {
+			lines = append(lines, comment.Text)
+		}
+	}
+
+	// NOTE: the directive is usually separated by the empty line.
+	for len(lines) != 0 && strings.TrimSpace(lines[len(lines)-1]) == "//" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// makePreset returns false when the variable is a preset for other options.
+func makePreset(spec *ast.ValueSpec, name, doc, structName, optionTypeName string) (Preset, bool, error) {
+	if len(spec.Names) != 1 || len(spec.Values) != 1 {
+		return Preset{}, false, fmt.Errorf("preset must be declared as a single variable with a value")
+	}
+
+	varName := spec.Names[0].Name
+
+	lit := asCompositeLit(spec.Values[0])
+	if lit == nil {
+		return Preset{}, false, fmt.Errorf("preset `%s`: value must be a composite literal", varName)
+	}
+
+	litType := spec.Type
+	if litType == nil {
+		litType = lit.Type
+	}
+
+	preset := Preset{
+		Name:    name,
+		VarName: varName,
+		Doc:     doc,
+		Setters: false,
+		Fields:  nil,
+	}
+
+	switch litType := litType.(type) {
+	case *ast.ArrayType:
+		elt, typeArgs := cutTypeArgs(litType.Elt)
+		if ident, ok := elt.(*ast.Ident); !ok || litType.Len != nil || ident.Name != optionTypeName {
+			return Preset{}, false, nil
+		}
+
+		preset.Setters = true
+		preset.TypeArgs = typeArgs
+	case *ast.Ident, *ast.IndexExpr, *ast.IndexListExpr:
+		typ, typeArgs := cutTypeArgs(litType)
+		if ident, ok := typ.(*ast.Ident); !ok || ident.Name != structName {
+			return Preset{}, false, nil
+		}
+
+		preset.TypeArgs = typeArgs
+
+		for _, elt := range lit.Elts {
+			keyValue, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return Preset{}, false, fmt.Errorf("preset `%s`: fields must be keyed", varName)
+			}
+
+			key, ok := keyValue.Key.(*ast.Ident)
+			if !ok {
+				return Preset{}, false, fmt.Errorf("preset `%s`: fields must be keyed", varName)
+			}
+
+			preset.Fields = append(preset.Fields, key.Name)
+		}
+	default:
+		return Preset{}, false, nil
+	}
+
+	if preset.Name == "" {
+		if isPublic(varName) {
+			return Preset{}, false, fmt.Errorf("preset `%s`: name is required for an exported variable", varName)
+		}
+
+		preset.Name = strings.ToUpper(varName[:1]) + varName[1:]
+	}
+
+	if !setterNamePattern.MatchString(preset.Name) {
+		return Preset{}, false, fmt.Errorf("preset `%s`: invalid name `%s`", varName, preset.Name)
+	}
+
+	return preset, true, nil
+}
+
+// cutTypeArgs returns the generic type and its type arguments of the
+// instantiation: Options[int, string] => Options, [int, string].
+func cutTypeArgs(expr ast.Expr) (ast.Expr, string) {
+	var indices []ast.Expr
+	switch casted := expr.(type) {
+	case *ast.IndexExpr:
+		expr, indices = casted.X, []ast.Expr{casted.Index}
+	case *ast.IndexListExpr:
+		expr, indices = casted.X, casted.Indices
+	default:
+		return expr, ""
+	}
+
+	args := make([]string, len(indices))
+	for i, index := range indices {
+		args[i] = types.ExprString(index)
+	}
+
+	return expr, "[" + strings.Join(args, ", ") + "]"
+}
@@ -0,0 +1,99 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPresets(t *testing.T) {
+	t.Parallel()
+
+	const source = `package testcase
+
+type Options struct {
+	timeout int
+	debug   bool
+}
+
+type OtherOptions struct {
+	name string
+}
+
+// Production settings.
+//
+//options-gen:preset
+var production = []OptOptionsSetter{WithTimeout(10)}
+
+var (
+	// options-gen:preset ForTesting
+	testing = Options{timeout: 1, debug: true}
+
+	//options-gen:preset
+	other = OtherOptions{name: "other"}
+
+	notPreset = Options{}
+)
+`
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "options.go")
+	writeTestFile(t, filename, source)
+
+	presets, err := GetPresets(filename, "Options", "OptOptionsSetter")
+	require.NoError(t, err)
+	require.Equal(t, []Preset{
+		{Name: "Production", VarName: "production", Doc: "// Production settings.", Setters: true},
+		{Name: "ForTesting", VarName: "testing", Fields: []string{"timeout", "debug"}},
+	}, presets)
+
+	t.Run("generic", func(t *testing.T) {
+		t.Parallel()
+
+		const source = `package testcase
+
+type Options[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+//options-gen:preset
+var production = []OptOptionsSetter[string, int]{WithKey[string, int]("prod")}
+
+//options-gen:preset
+var testing = Options[string, int]{value: 1}
+`
+
+		dir := t.TempDir()
+		filename := filepath.Join(dir, "options.go")
+		writeTestFile(t, filename, source)
+
+		presets, err := GetPresets(filename, "Options", "OptOptionsSetter")
+		require.NoError(t, err)
+		require.Equal(t, []Preset{
+			{Name: "Production", VarName: "production", Setters: true, TypeArgs: "[string, int]"},
+			{Name: "Testing", VarName: "testing", Fields: []string{"value"}, TypeArgs: "[string, int]"},
+		}, presets)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		for name, source := range map[string]string{
+			"exported":   "package testcase\n\n//options-gen:preset\nvar Production = Options{}\n",
+			"not_keyed":  "package testcase\n\n//options-gen:preset\nvar production = Options{1}\n",
+			"not_a_lit":  "package testcase\n\n//options-gen:preset\nvar production = getOptions()\n",
+			"bad_name":   "package testcase\n\n//options-gen:preset forTests\nvar production = Options{}\n",
+			"multi_vars": "package testcase\n\n//options-gen:preset\nvar a, b = Options{}, Options{}\n",
+		} {
+			dir := t.TempDir()
+			filename := filepath.Join(dir, "options.go")
+			writeTestFile(t, filename, source)
+
+			_, err := GetPresets(filename, "Options", "OptOptionsSetter")
+			require.Error(t, err, name)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+)
+
+// GetSourceOptionSpec returns options of the struct structName, which is
+// declared by src instead of a file of the package. src is checked as if it
+// was a file of workDir. When workDir is empty, the package is unknown and
+// types are resolved by the syntax only. The returned declaration makes the
+// generated file declare the struct.
+func GetSourceOptionSpec(
+	src []byte,
+	structName, workDir, tagName string,
+	allVariadic bool,
+	excludes []*regexp.Regexp,
+) (*GetOptionSpecRes, *StructDecl, error) {
+	fset := token.NewFileSet()
+
+	// NOTE: the name must not clash with files of the package, because src
+	// replaces the file of the same name, when it is type-checked.
+	filePath := path.Join(workDir, "options461e464ebed9.go")
+
+	file, err := parser.ParseFile(fset, filePath, src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse struct: %w", err)
+	}
+
+	genDecl, typeSpec, structType := findSourceStruct(file, structName)
+	if structType == nil {
+		return nil, nil, fmt.Errorf("cannot find struct `%s`", structName)
+	}
+
+	var typed *typedFile
+	if workDir != "" {
+		// NOTE: src is parsed again by the type checker, so types are
+		// resolved for its declaration of the struct.
+		typed, err = checkSourceFile(fset, filePath, src, structName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		file = typed.file
+		genDecl, typeSpec, structType = findSourceStruct(file, structName)
+	}
+
+	res, err := getOptionSpec(fset, workDir, file, typed,
+		extractFields(typeSpec.TypeParams), extractFields(structType.Fields), tagName, allVariadic, excludes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := typeSpec.Doc
+	if doc == nil {
+		doc = genDecl.Doc
+	}
+
+	decl := &StructDecl{
+		Doc:    formatComment(doc.Text()),
+		Fields: make([]StructField, 0, len(structType.Fields.List)),
+	}
+
+	for _, field := range structType.Fields.List {
+		var tag string
+		if field.Tag != nil {
+			tag = field.Tag.Value
+		}
+
+		for _, name := range field.Names {
+			decl.Fields = append(decl.Fields, StructField{
+				Doc:  formatComment(field.Doc.Text()),
+				Name: name.Name,
+				Type: types.ExprString(field.Type),
+				Tag:  tag,
+			})
+		}
+	}
+
+	return res, decl, nil
+}
+
+func findSourceStruct(file *ast.File, structName string) (*ast.GenDecl, *ast.TypeSpec, *ast.StructType) {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != structName {
+				continue
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				return nil, nil, nil
+			}
+
+			return genDecl, typeSpec, structType
+		}
+	}
+
+	return nil, nil, nil
+}
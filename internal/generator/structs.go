@@ -4,6 +4,9 @@ type OptionSpec struct {
 	TypeParamsSpec string // [KeyT int | string, TT any]
 	TypeParams     string // [KeyT, TT]
 	Options        []OptionMeta
+	// SourcesField is the field of type optionsinfo.Sources, which keeps
+	// sources of options. It is not an option.
+	SourcesField string
 }
 
 func (s OptionSpec) HasValidation() bool {
@@ -16,12 +19,74 @@ func (s OptionSpec) HasValidation() bool {
 	return false
 }
 
+func (s OptionSpec) HasEnv() bool {
+	for _, o := range s.Options {
+		if o.TagOption.Env != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DecodeKey returns the key, which is used to decode the option from the map.
+func (m OptionMeta) DecodeKey() string {
+	if m.TagOption.Key != "" {
+		return m.TagOption.Key
+	}
+
+	return snakeCase(m.Field)
+}
+
+// StructDecl is the options struct, which is declared by the generated file
+// instead of the source.
+type StructDecl struct {
+	Doc    string // contains a comment with `//`. Can be empty.
+	Fields []StructField
+}
+
+type StructField struct {
+	Doc  string // contains a comment with `//`. Can be empty.
+	Name string
+	Type string
+	Tag  string // contains the tag with backquotes. Can be empty.
+}
+
 type OptionMeta struct {
 	Name      string
 	Docstring string // contains a comment with `//`. Can be empty or contain a multi-line string.
 	Field     string
+	// Type is the type expression as written in the struct. It is kept as
+	// is, because the generated file uses imports of the source file.
 	Type      string
 	TagOption TagOption
+	// ValueType is the basic type of the named Type resolved by the type
+	// checker, like int for `type Port int`. Empty when Type is parsed as is.
+	ValueType string
+	// Kind is the kind of the underlying type resolved by the type checker.
+	Kind Kind
+}
+
+// Kind is the kind of the underlying type of the option.
+type Kind string
+
+const (
+	KindUnknown   Kind = ""
+	KindSlice     Kind = "slice"
+	KindMap       Kind = "map"
+	KindFunc      Kind = "func"
+	KindChan      Kind = "chan"
+	KindInterface Kind = "interface"
+	KindPointer   Kind = "pointer"
+)
+
+// ParseType returns the type, which values of the option are parsed as.
+func (m OptionMeta) ParseType() string {
+	if m.ValueType != "" {
+		return m.ValueType
+	}
+
+	return m.Type
 }
 
 type TagOption struct {
@@ -32,4 +97,11 @@ type TagOption struct {
 	VariadicIsSet bool
 	Skip          bool
 	Name          string
+	Env           string
+	Key           string
+	Secret        bool
+	Aliases       []string
+	Deprecated    string
+	Group         string
+	Standalone    bool
 }
@@ -1,17 +1,60 @@
 // Code generated by options-gen {{ .version }}. DO NOT EDIT.
+{{- if .defaultsFile }}
+// Defaults from {{ .defaultsFile.Path }} (sha256:{{ .defaultsFile.Hash }}).
+{{- end }}
 
 package {{ .packageName }}{{$hasGoValidator := false}}{{ range .options }}{{- if .TagOption.GoValidator }}{{$hasGoValidator = true}}{{break}}{{end}}{{end}}
 
 import (
-	{{if $hasGoValidator}}fmt461e464ebed9 "fmt"
-	{{ if .hasValidation }}errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
-{{end}}{{end}}{{ if .hasValidation }}validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"{{ end }}
+	{{- if or $hasGoValidator .withDecode .withStringer }}
+	fmt461e464ebed9 "fmt"
+	{{- end }}
+	{{- if or .hasValidation .hasEnv .withDecode }}
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	{{- end }}
+	{{- if or .hasEnv .withFlags }}
+	strconv461e464ebed9 "strconv"
+	time461e464ebed9 "time"
+	{{- end }}
+	{{- if .withFlags }}
+	flag461e464ebed9 "flag"
+	{{- end }}
+	{{- if .withDecode }}
+	decoder461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/decoder"
+	{{- end }}
+	{{- if .withStringer }}
+	slog461e464ebed9 "log/slog"
+	{{- end }}
+	{{- if or .withStringer .withSpec }}
+	redact461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/redact"
+	{{- end }}
+	{{- if .withSpec }}
+	iter461e464ebed9 "iter"
+	{{- end }}
+	{{- if or .withSpec .withSource }}
+	optionsinfo461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+	{{- end }}
+	{{- if .hasValidation }}
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	{{- end }}
 	{{- range $import := .imports }}
 		{{ if $import.Alias }}{{ $import.Alias }}{{ end }} {{ $import.Path -}}
 	{{- end }}
 )
 
-{{ if .withIsset }}
+{{ with .structDecl }}
+{{ .Doc }}
+type {{ $.optionsStructName }}{{ $.optionsTypeParamsSpec }} struct {
+	{{- range .Fields }}
+	{{- if .Doc }}
+	{{ .Doc }}
+	{{- end }}
+	{{ .Name }} {{ .Type }}{{ if .Tag }} {{ .Tag }}{{ end }}
+	{{- end }}
+}
+{{ end }}
+
+{{ if or .withIsset .withSource }}
 type opt{{$.optionsPrefix}}Field int8
 const(
 	{{ range $i, $field := .options }}
@@ -19,74 +62,69 @@ const(
 	{{- end -}}
 )
 
+{{ if .withIsset }}
 var opt{{$.optionsPrefix}}IsSet = [{{ .optionsLen }}]bool{}
 {{ end }}
+{{ end }}
 
 type {{$.optionsTypeName}}{{ $.optionsTypeParamsSpec }} func(o *{{ .optionsStructInstanceType }})
 
-{{if ne .constructorTypeRender "no" }}
-func {{if eq .constructorTypeRender "public" }}New{{else}}new{{end}}{{ .optionsStructType }}(
+{{ if eq .style "builder" }}
+// {{ .builderTypeName }} builds {{ .optionsStructName }} step by step.
+type {{ .builderTypeName }}{{ .optionsTypeParamsSpec }} struct {
+	o {{ .optionsStructInstanceType }}
+}
+
+{{ if ne .constructorTypeRender "no" }}
+{{ .constructorDoc }}
+func {{ .constructorName }}{{ .optionsTypeParamsSpec }}(
 	{{ range .options -}}
 		{{ if .TagOption.IsRequired -}}
 			{{ .TargetField }} {{ .Type }},
 		{{ end }}
 	{{- end -}}
-	options ...{{$.optionsTypeName}}{{ $.optionsTypeParams }},
-) {{ .optionsStructInstanceType }} {
-	var o {{ .optionsStructInstanceType }}
-	{{ if .withIsset }}
-		var empty [{{ .optionsLen }}]bool
-		opt{{$.optionsPrefix}}IsSet = empty
-	{{ end }}
-
-	{{ if .defaultsVarName }}
-		// Setting defaults from variable
-		{{ range .options -}}
-			o.{{ .Field }} = {{ $.defaultsVarName }}.{{ .Field }}
-      {{- if $.withIsset }}
-				opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
-      {{- end }}
-    {{ end }}
-	{{ end }}
-
-	{{ if .defaultsFuncName }}
-		// Setting defaults from func
-		defaultOpts := {{ $.defaultsFuncName }}{{ $.optionsTypeParams }}()
-		{{ range .options -}}
-			o.{{ .Field }} = defaultOpts.{{ .Field }}
-      {{- if $.withIsset }}
-				opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
-      {{- end }}
-    {{ end }}
-	{{ end }}
+) *{{ .builderTypeName }}{{ $.optionsTypeParams }} {
+{{ template "constructorBody" $ }}
+	return &{{ .builderTypeName }}{{ $.optionsTypeParams }}{o: o}
+}
+{{ end }}
 
-	{{ if .defaultsTagName }}
-		// Setting defaults from field tag (if present)
-    {{ range .options -}}
-      {{ if .TagOption.Default -}}
-        {{- if eq .Type "time.Duration" }}
-	        o.{{ .Field }}, _ = time.ParseDuration("{{ .TagOption.Default }}")
-        {{- else if eq .Type "string" }}
-	        o.{{ .Field }} = "{{ .TagOption.Default }}"
-        {{- else }}
-	        o.{{ .Field }} = {{ .TagOption.Default }}
-        {{- end }}
-        {{- if $.withIsset }}
-          opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
-        {{- end }}
-      {{- end }}
-    {{- end }}
-	{{- end }}
+// Apply applies the setters to the options.
+func (b *{{ .builderTypeName }}{{ $.optionsTypeParams }}) Apply(options ...{{$.optionsTypeName}}{{ $.optionsTypeParams }}) *{{ .builderTypeName }}{{ $.optionsTypeParams }} {
+	for _, opt := range options {
+		opt(&b.o)
+	}
+	return b
+}
 
-	{{ range .options }}
-	    {{- if .TagOption.IsRequired -}}
-	        o.{{ .Field }} = {{ .TargetField }}
-          {{- if $.withIsset }}
-		        opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
-          {{- end }}
-      {{ end -}}
-	{{ end }}
+// Build validates and returns the options.
+func (b *{{ .builderTypeName }}{{ $.optionsTypeParams }}) Build() ({{ .optionsStructInstanceType }}, error) {
+	if err := b.o.Validate(); err != nil {
+		var empty {{ .optionsStructInstanceType }}
+		return empty, err
+	}
+	return b.o, nil
+}
 
+// MustBuild is like Build, but panics if the options are not valid.
+func (b *{{ .builderTypeName }}{{ $.optionsTypeParams }}) MustBuild() {{ .optionsStructInstanceType }} {
+	o, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+{{ else if ne .constructorTypeRender "no" }}
+{{ .constructorDoc }}
+func {{ .constructorName }}{{ .optionsTypeParamsSpec }}(
+	{{ range .options -}}
+		{{ if .TagOption.IsRequired -}}
+			{{ .TargetField }} {{ .Type }},
+		{{ end }}
+	{{- end -}}
+	options ...{{$.optionsTypeName}}{{ $.optionsTypeParams }},
+) {{ .optionsStructInstanceType }} {
+{{ template "constructorBody" $ }}
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -95,12 +133,17 @@ func {{if eq .constructorTypeRender "public" }}New{{else}}new{{end}}{{ .optionsS
 {{end}}
 
 {{ range .options }}
-	{{ if not .TagOption.IsRequired }}
-		{{- if ne .Docstring "" -}}
-			{{ .Docstring }}
+	{{ if .HasSetter }}
+		{{- if ne .SetterDoc "" -}}
+			{{ .SetterDoc }}
 		{{- end }}
-		func With{{$.optionsPrefix}}{{ .TargetName }}{{ $.optionsTypeParamsSpec }}(opt {{if .TagOption.Variadic}}...{{end}}{{ .Type }}) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+		{{ if eq $.style "builder" -}}
+		func (b *{{ $.builderTypeName }}{{ $.optionsTypeParams }}) {{ .SetterName }}(opt {{if .TagOption.Variadic}}...{{end}}{{ .Type }}) *{{ $.builderTypeName }}{{ $.optionsTypeParams }} {
+			o := &b.o;
+		{{- else -}}
+		func {{ .SetterName }}{{ $.optionsTypeParamsSpec }}(opt {{if .TagOption.Variadic}}...{{end}}{{ .Type }}) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
 			return func(o *{{ $.optionsStructInstanceType }}) {
+		{{- end }}
 				{{- if .TagOption.Variadic -}}
 					o.{{ .Field }} = append(o.{{ .Field }}, opt...)
 				{{- else -}}
@@ -109,11 +152,321 @@ func {{if eq .constructorTypeRender "public" }}New{{else}}new{{end}}{{ .optionsS
 				{{ if $.withIsset }}
 					opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
 				{{- end -}}
+				{{ if $.withSource }}
+					o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceSetter)
+				{{- end -}}
+		{{ if eq $.style "builder" }}
+			return b
+		}
+		{{ else -}}
+			}
+		}
+		{{ end }}
+
+		{{ $opt := . }}
+		{{- range .AliasNames }}
+			// {{ . }} sets {{ $opt.Field }}.
+			//
+			// Deprecated: use {{ $opt.SetterName }} instead.
+			{{ if eq $.style "builder" -}}
+			func (b *{{ $.builderTypeName }}{{ $.optionsTypeParams }}) {{ . }}(opt {{if $opt.TagOption.Variadic}}...{{end}}{{ $opt.Type }}) *{{ $.builderTypeName }}{{ $.optionsTypeParams }} {
+				return b.{{ $opt.SetterName }}(opt{{if $opt.TagOption.Variadic}}...{{end}})
+			}
+			{{- else -}}
+			func {{ . }}{{ $.optionsTypeParamsSpec }}(opt {{if $opt.TagOption.Variadic}}...{{end}}{{ $opt.Type }}) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+				return {{ $opt.SetterName }}{{ $.optionsTypeParams }}(opt{{if $opt.TagOption.Variadic}}...{{end}})
 			}
+			{{- end }}
+		{{ end }}
+	{{ end }}
+{{ end }}
+
+{{ range .groups }}
+	{{ .SetterDoc }}
+	{{ if eq $.style "builder" -}}
+	func (b *{{ $.builderTypeName }}{{ $.optionsTypeParams }}) {{ .SetterName }}(
+		{{- range .Options }}{{ .TargetField }} {{ if .TagOption.Variadic }}[]{{ end }}{{ .Type }}, {{ end -}}
+	) *{{ $.builderTypeName }}{{ $.optionsTypeParams }} {
+		o := &b.o
+	{{- else -}}
+	func {{ .SetterName }}{{ $.optionsTypeParamsSpec }}(
+		{{- range .Options }}{{ .TargetField }} {{ if .TagOption.Variadic }}[]{{ end }}{{ .Type }}, {{ end -}}
+	) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+		return func(o *{{ $.optionsStructInstanceType }}) {
+	{{- end }}
+			{{- range .Options }}
+				{{ if .TagOption.Variadic -}}
+					o.{{ .Field }} = append(o.{{ .Field }}, {{ .TargetField }}...)
+				{{- else -}}
+					o.{{ .Field }} = {{ .TargetField }}
+				{{- end -}}
+				{{ if $.withIsset }}
+					opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+				{{- end -}}
+				{{ if $.withSource }}
+					o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceSetter)
+				{{- end -}}
+			{{- end }}
+	{{- if eq $.style "builder" }}
+		return b
+	}
+	{{ else -}}
 		}
+	}
 	{{ end }}
 {{ end }}
 
+{{ if .withCombinators }}
+// If{{ .optionsPrefix }} returns the setter when cond is true and the setter that does nothing
+// otherwise.
+func If{{ .optionsPrefix }}{{ $.optionsTypeParamsSpec }}(cond bool, opt {{$.optionsTypeName}}{{ $.optionsTypeParams }}) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+	if !cond {
+		return func(*{{ $.optionsStructInstanceType }}) {}
+	}
+
+	return opt
+}
+
+// Chain{{ .optionsPrefix }} combines the setters into one. Setters are applied in order.
+func Chain{{ .optionsPrefix }}{{ $.optionsTypeParamsSpec }}(opts ...{{$.optionsTypeName}}{{ $.optionsTypeParams }}) {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+	return func(o *{{ $.optionsStructInstanceType }}) {
+		for _, opt := range opts {
+			opt(o)
+		}
+	}
+}
+{{ end }}
+
+{{ range .presets }}
+	{{ .Doc }}
+	func {{ .Name }}() {{$.optionsTypeName}}{{ .TypeArgs }} {
+		return func(o *{{ $.optionsStructName }}{{ .TypeArgs }}) {
+			{{- if .Setters }}
+				for _, opt := range {{ .VarName }} {
+					opt(o)
+				}
+			{{- else }}
+				{{- $preset := . }}
+				{{- range .Fields }}
+					o.{{ . }} = {{ $preset.VarName }}.{{ . }}
+					{{- if $.withIsset }}
+						opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ . }}] = true
+					{{- end -}}
+					{{ if $.withSource }}
+						o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ . }}), optionsinfo461e464ebed9.SourceSetter)
+					{{- end -}}
+				{{- end }}
+			{{- end }}
+		}
+	}
+{{ end }}
+
+{{ if .hasEnv }}
+// Load{{ .optionsStructName }}FromEnv reads options from the environment variables by lookup function
+// (for example, os.LookupEnv). Returned setters should be passed to the
+// constructor before other setters, so the explicitly set options will take
+// precedence over the environment.
+func Load{{ .optionsStructName }}FromEnv{{ $.optionsTypeParamsSpec }}(lookup func(string) (string, bool)) ([]{{$.optionsTypeName}}{{ $.optionsTypeParams }}, error) {
+	var setters []{{$.optionsTypeName}}{{ $.optionsTypeParams }}
+	errs := new(errors461e464ebed9.ParseErrors)
+	{{- range .options }}
+		{{- if .TagOption.Env }}
+			if raw, ok := lookup("{{ $.envPrefix }}{{ .TagOption.Env }}"); ok {
+				value, err := {{ .ParseExpr }}
+				if err != nil {
+					errs.Add(errors461e464ebed9.New{{ if .TagOption.Secret }}Secret{{ end }}ParseError("{{ $.envPrefix }}{{ .TagOption.Env }}", raw, err))
+				} else {
+					setters = append(setters, func(o *{{ $.optionsStructInstanceType }}) {
+						o.{{ .Field }} = {{ .Type }}(value)
+						{{- if $.withIsset }}
+							opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+						{{- end }}
+						{{- if $.withSource }}
+							o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceEnv)
+						{{- end }}
+					})
+				}
+			}
+		{{- end }}
+	{{- end }}
+
+	if err := errs.AsError(); err != nil {
+		return nil, err
+	}
+
+	return setters, nil
+}
+{{ end }}
+
+{{ if .withFlags }}
+// Register{{ .optionsStructName }}Flags registers flags for options in fs. Flag names
+// will be prefixed by prefix. Returned function should be called after parsing
+// the flags. It returns the setter, which applies only provided flags.
+func Register{{ .optionsStructName }}Flags{{ $.optionsTypeParamsSpec }}(fs *flag461e464ebed9.FlagSet, prefix string) func() {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+	var setters []{{$.optionsTypeName}}{{ $.optionsTypeParams }}
+	{{- range .options }}
+		{{- if .FlagName }}
+			fs.{{ if eq .ParseType "bool" }}BoolFunc{{ else }}Func{{ end }}(prefix+"{{ .FlagName }}", {{ printf "%q" .FlagUsage }}, func(raw string) error {
+				value, err := {{ .ParseExpr }}
+				if err != nil {
+					return err
+				}
+
+				setters = append(setters, func(o *{{ $.optionsStructInstanceType }}) {
+					o.{{ .Field }} = {{ .Type }}(value)
+					{{- if $.withIsset }}
+						opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+					{{- end }}
+					{{- if $.withSource }}
+						o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceFlag)
+					{{- end }}
+				})
+
+				return nil
+			})
+			{{- if .TagOption.Default }}
+				fs.Lookup(prefix+"{{ .FlagName }}").DefValue = {{ printf "%q" .TagOption.Default }}
+			{{- end }}
+		{{- end }}
+	{{- end }}
+
+	return func() {{$.optionsTypeName}}{{ $.optionsTypeParams }} {
+		provided := setters
+
+		return func(o *{{ $.optionsStructInstanceType }}) {
+			for _, setter := range provided {
+				setter(o)
+			}
+		}
+	}
+}
+{{ end }}
+
+{{ if .withDecode }}
+// {{ .optionsStructName }}FromMap returns setters for the options that are present in m. Mandatory
+// options are not decoded, they should be passed to the constructor.
+{{- if .decodeStrict }}
+// Unknown keys are not allowed.
+{{- end }}
+func {{ .optionsStructName }}FromMap{{ $.optionsTypeParamsSpec }}(m map[string]any) ([]{{$.optionsTypeName}}{{ $.optionsTypeParams }}, error) {
+	var setters []{{$.optionsTypeName}}{{ $.optionsTypeParams }}
+	errs := new(errors461e464ebed9.ParseErrors)
+	{{- range .options }}
+		{{- if not .TagOption.IsRequired }}
+			if raw, ok := m["{{ .DecodeKey }}"]; ok {
+				value, err := decoder461e464ebed9.Decode[{{ if .TagOption.Variadic }}[]{{ end }}{{ .Type }}](raw)
+				if err != nil {
+					errs.Add(errors461e464ebed9.New{{ if .TagOption.Secret }}Secret{{ end }}ParseError("{{ .DecodeKey }}", fmt461e464ebed9.Sprint(raw), err))
+				} else {
+					setters = append(setters, func(o *{{ $.optionsStructInstanceType }}) {
+						{{- if .TagOption.Variadic }}
+							o.{{ .Field }} = append(o.{{ .Field }}, value...)
+						{{- else }}
+							o.{{ .Field }} = value
+						{{- end }}
+						{{- if $.withIsset }}
+							opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+						{{- end }}
+						{{- if $.withSource }}
+							o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceDecoded)
+						{{- end }}
+					})
+				}
+			}
+		{{- end }}
+	{{- end }}
+	{{- if .decodeStrict }}
+
+		unknownKeys := decoder461e464ebed9.UnknownKeys(m{{ range .options }}{{ if not .TagOption.IsRequired }}, "{{ .DecodeKey }}"{{ end }}{{ end }})
+		for _, key := range unknownKeys {
+			errs.Add(errors461e464ebed9.NewParseError(key, fmt461e464ebed9.Sprint(m[key]), decoder461e464ebed9.ErrUnknownKey))
+		}
+	{{- end }}
+
+	if err := errs.AsError(); err != nil {
+		return nil, err
+	}
+
+	return setters, nil
+}
+
+// {{ .optionsStructName }}FromJSON decodes JSON object and returns setters the same way as {{ .optionsStructName }}FromMap.
+func {{ .optionsStructName }}FromJSON{{ $.optionsTypeParamsSpec }}(data []byte) ([]{{$.optionsTypeName}}{{ $.optionsTypeParams }}, error) {
+	m, err := decoder461e464ebed9.JSONMap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return {{ .optionsStructName }}FromMap{{ $.optionsTypeParams }}(m)
+}
+{{ end }}
+
+{{ if .withSpec }}
+// {{ .optionsStructName }}Spec returns the description of all options.
+func {{ .optionsStructName }}Spec() []optionsinfo461e464ebed9.FieldInfo {
+	return []optionsinfo461e464ebed9.FieldInfo{
+	{{- range .options }}
+		{
+			Name:      {{ printf "%q" .Field }},
+			Setter:    "{{ .PublicSetterName }}",
+			Type:      "{{ if .TagOption.Variadic }}[]{{ end }}{{ .Type }}",
+			Default:   {{ printf "%q" .SpecDefault }},
+			Mandatory: {{ .TagOption.IsRequired }},
+			Variadic:  {{ .TagOption.Variadic }},
+			Secret:    {{ .TagOption.Secret }},
+			Validate:  {{ printf "%q" .TagOption.GoValidator }},
+			Doc:       {{ printf "%q" .Doc }},
+		},
+	{{- end }}
+	}
+}
+
+// SpecValues iterates over options description and the current values. Values
+// of secret options are masked.
+func (o *{{ .optionsStructInstanceType }}) SpecValues() iter461e464ebed9.Seq2[optionsinfo461e464ebed9.FieldInfo, any] {
+	return func(yield func(optionsinfo461e464ebed9.FieldInfo, any) bool) {
+		spec := {{ .optionsStructName }}Spec()
+	{{- range $i, $opt := .options }}
+		if !yield(spec[{{ $i }}], {{ if .TagOption.Secret }}redact461e464ebed9.Mask{{ else }}o.{{ .Field }}{{ end }}) {
+			return
+		}
+	{{- end }}
+	}
+}
+{{ end }}
+
+{{ if .withStringer }}
+// String returns the options representation, which is safe to print: secret
+// values are masked and collections are summarised.
+func (o {{ .optionsStructInstanceType }}) String() string {
+	return "{{ .optionsStructName }}{" +
+	{{- range $i, $opt := .options }}
+		{{ if $i }}", " + {{ end }}"{{ .Field }}: " + {{ .StringExpr }} +
+		{{- if $.withSource }} " (" + o.{{ $.sourcesField }}.Get(int(Field{{$.optionsPrefix}}{{ .Field }})).String() + ")" +{{ end }}
+	{{- end }}
+		"}"
+}
+
+// LogValue implements slog.LogValuer. Values are represented the same way as in String.
+{{- if .withSource }}
+// Each option is a group with the value and the source.
+{{- end }}
+func (o {{ .optionsStructInstanceType }}) LogValue() slog461e464ebed9.Value {
+	return slog461e464ebed9.GroupValue(
+	{{- range .options }}
+		{{- if $.withSource }}
+			slog461e464ebed9.Group("{{ .Field }}",
+				slog461e464ebed9.Any("value", {{ .LogValueExpr }}),
+				slog461e464ebed9.String("source", o.{{ $.sourcesField }}.Get(int(Field{{$.optionsPrefix}}{{ .Field }})).String()),
+			),
+		{{- else }}
+			slog461e464ebed9.Any("{{ .Field }}", {{ .LogValueExpr }}),
+		{{- end }}
+	{{- end }}
+	)
+}
+{{ end }}
+
 func (o *{{ .optionsStructInstanceType }}) Validate() error {
 	{{- if not .hasValidation -}}
 		return nil
@@ -134,6 +487,13 @@ func (o *{{ .optionsStructInstanceType }}) Validate() error {
 	}
 {{ end }}
 
+{{ if .withSource }}
+	// Source returns where the current value of the field came from.
+	func (o *{{ .optionsStructInstanceType }}) Source(field opt{{$.optionsPrefix}}Field) optionsinfo461e464ebed9.OptionSource {
+	return o.{{ $.sourcesField }}.Get(int(field))
+	}
+{{ end }}
+
 {{ range .options }}
 	{{- if .TagOption.GoValidator }}
 		func _validate_{{ $.optionsStructName }}_{{ .Field }}{{ $.optionsTypeParamsSpec }}(o *{{ $.optionsStructInstanceType }}) error {
@@ -144,3 +504,104 @@ func (o *{{ .optionsStructInstanceType }}) Validate() error {
 		}
 	{{- end }}
 {{ end }}
+
+
+{{ define "defaultValues" }}
+	{{- range .options -}}
+		{{ if .TagOption.Default -}}
+			{{- if eq .ParseType "time.Duration" }}
+				o.{{ .Field }}, _ = time.ParseDuration({{ printf "%q" .TagOption.Default }})
+			{{- else if eq .ParseType "string" }}
+				o.{{ .Field }} = {{ printf "%q" .TagOption.Default }}
+			{{- else }}
+				o.{{ .Field }} = {{ .TagOption.Default }}
+			{{- end }}
+			{{- if $.withIsset }}
+				opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+			{{- end }}
+			{{- if $.withSource }}
+				o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.{{ if $.defaultsFile }}SourceFile{{ else }}SourceTag{{ end }})
+			{{- end }}
+		{{- end }}
+	{{- end }}
+{{- end }}
+
+{{ with .funcWrapper }}
+{{ .Doc }}
+func {{ .Name }}({{ range .Params }}{{ . }}, {{ end }}options ...{{ $.optionsTypeName }}) ({{ .Results }}) {
+	o := {{ $.constructorName }}({{ range .MandatoryArgs }}{{ . }}, {{ end }}options...)
+	if err := o.Validate(); err != nil {
+		{{- range $i, $type := .ZeroResults }}
+		var res{{ $i }} {{ $type }}
+		{{- end }}
+		return {{ range $i, $type := .ZeroResults }}res{{ $i }}, {{ end }}err
+	}
+
+	{{ if .ReturnsError -}}
+	return {{ .FuncName }}({{ .CallArgs }})
+	{{- else if .ZeroResults -}}
+	{{ .ResultVars }} := {{ .FuncName }}({{ .CallArgs }})
+	return {{ .ResultVars }}, nil
+	{{- else -}}
+	{{ .FuncName }}({{ .CallArgs }})
+	return nil
+	{{- end }}
+}
+{{ end }}
+
+{{ define "constructorBody" -}}
+	var o {{ .optionsStructInstanceType }}
+	{{ if .withIsset }}
+		var empty [{{ .optionsLen }}]bool
+		opt{{$.optionsPrefix}}IsSet = empty
+	{{ end }}
+
+	{{ if .defaultsVarName }}
+		// Setting defaults from variable
+		{{ range .options -}}
+			o.{{ .Field }} = {{ $.defaultsVarName }}.{{ .Field }}
+      {{- if $.withIsset }}
+				opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+      {{- end }}
+      {{- if $.withSource }}
+				o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceVar)
+      {{- end }}
+    {{ end }}
+	{{ end }}
+
+	{{ if .defaultsFuncName }}
+		// Setting defaults from func
+		defaultOpts := {{ $.defaultsFuncName }}{{ $.optionsTypeParams }}()
+		{{ range .options -}}
+			o.{{ .Field }} = defaultOpts.{{ .Field }}
+      {{- if $.withIsset }}
+				opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+      {{- end }}
+      {{- if $.withSource }}
+				o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceFunc)
+      {{- end }}
+    {{ end }}
+	{{ end }}
+
+	{{ if .defaultsTagName }}
+		// Setting defaults from field tag (if present)
+		{{ template "defaultValues" $ }}
+	{{- end }}
+
+	{{ if .defaultsFile }}
+		// Setting defaults from file {{ .defaultsFile.Path }}
+		{{ template "defaultValues" $ }}
+	{{- end }}
+
+	{{ range .options }}
+	    {{- if .TagOption.IsRequired -}}
+	        o.{{ .Field }} = {{ .TargetField }}
+          {{- if $.withIsset }}
+		        opt{{$.optionsPrefix}}IsSet[Field{{$.optionsPrefix}}{{ .Field }}] = true
+          {{- end }}
+          {{- if $.withSource }}
+		        o.{{ $.sourcesField }}.Set(int(Field{{$.optionsPrefix}}{{ .Field }}), optionsinfo461e464ebed9.SourceMandatory)
+          {{- end }}
+      {{ end -}}
+	{{ end }}
+{{- end }}
@@ -0,0 +1,316 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var testsTmpl = template.Must(template.New("options_test.go.tpl").Funcs(template.FuncMap{
+	"testSetterCall":  testSetterCall,
+	"testGroupValues": testGroupValues,
+}).ParseFS(templates, "templates/options_test.go.tpl"))
+
+type templateTestOption struct {
+	templateOptionMeta
+	// Value is the expression of the value for the setter.
+	Value string
+	// FuzzValue is the expression of the value built from the fuzz arguments.
+	FuzzValue string
+	// Default is the expression of the expected default value.
+	Default string
+	// Comparable is false for values that can not be compared with ==.
+	Comparable bool
+	// CheckUnset is true when IsSet should be false before the setter call.
+	CheckUnset bool
+}
+
+type templateTestGroup struct {
+	SetterName string
+	Options    []templateTestOption
+}
+
+// templateUntestedSetter is a setter, which is skipped by the tests.
+type templateUntestedSetter struct {
+	SetterName string
+	Reason     string
+}
+
+// RenderTests will render the test file for the generated options. Tests
+// check defaults, setters, IsSet and required fields and contain a fuzz
+// target. Only options with basic types (the same as for tag defaults) are
+// tested, setters of other options are skipped with t.Skip.
+func RenderTests(opts Options) ([]byte, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("bad configuration: %w", err)
+	}
+
+	if opts.spec.TypeParams != "" {
+		return nil, fmt.Errorf("tests are not supported for generic options")
+	}
+
+	hasConstructor := opts.constructorTypeRender != "no"
+	hasDefaultsFromCode := opts.varName != "" || opts.funcName != ""
+
+	options := makeTemplateOptions(opts)
+
+	var (
+		mandatory []string
+		defaults  []templateTestOption
+		setters   []templateTestOption
+		untested  []templateUntestedSetter
+		required  []string
+	)
+
+	testOptions := make(map[string]templateTestOption, len(options))
+	for _, opt := range options {
+		testOpt, err := makeTemplateTestOption(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		testOpt.CheckUnset = hasConstructor && !hasDefaultsFromCode &&
+			!opt.TagOption.IsRequired && opt.TagOption.Default == ""
+		testOptions[opt.Field] = testOpt
+
+		if opt.TagOption.IsRequired {
+			mandatory = append(mandatory, "*new("+opt.Type+")")
+		}
+
+		if opt.TagOption.Default != "" && testOpt.Default != "" {
+			defaults = append(defaults, testOpt)
+		}
+
+		switch {
+		case !opt.HasSetter():
+		case testOpt.Value != "":
+			setters = append(setters, testOpt)
+		default:
+			untested = append(untested, templateUntestedSetter{
+				SetterName: opt.SetterName,
+				Reason:     "values of " + opt.Type + " are not generated",
+			})
+		}
+
+		if !hasDefaultsFromCode && opt.TagOption.Default == "" && hasRequiredRule(opt.TagOption.GoValidator) {
+			required = append(required, opt.Field)
+		}
+	}
+
+	var groups []templateTestGroup
+	for _, group := range makeTemplateGroups(options) {
+		testGroup := templateTestGroup{SetterName: group.SetterName, Options: nil}
+		for _, opt := range group.Options {
+			if testOptions[opt.Field].Value == "" {
+				testGroup.Options = nil
+				untested = append(untested, templateUntestedSetter{
+					SetterName: group.SetterName,
+					Reason:     "values of " + opt.Type + " are not generated",
+				})
+
+				break
+			}
+
+			testGroup.Options = append(testGroup.Options, testOptions[opt.Field])
+		}
+
+		if len(testGroup.Options) != 0 {
+			groups = append(groups, testGroup)
+		}
+	}
+
+	tplContext := map[string]interface{}{
+		"version":     opts.version,
+		"packageName": opts.packageName,
+		"imports":     opts.fileImports,
+
+		"optionsPrefix":     opts.prefix,
+		"optionsStructName": opts.optionsStructName,
+		"withIsset":         opts.withIsset,
+
+		"style":           opts.style,
+		"builderTypeName": opts.builderTypeName(),
+		"hasConstructor":  hasConstructor,
+		"constructorName": opts.resolveConstructorName(),
+		"mandatory":       mandatory,
+
+		"checkDefaults": hasConstructor && (opts.tagName != "" || opts.defaultsFile != nil),
+		"defaults":      defaults,
+		"setters":       setters,
+		"groups":        groups,
+		"untested":      untested,
+		// NOTE: defaults from code are not known, so the setter test is
+		// skipped, when the default is the test value.
+		"defaultsFromCode": hasDefaultsFromCode,
+		"required":         required,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := testsTmpl.Execute(buf, tplContext); err != nil {
+		return nil, fmt.Errorf("cannot render tests template: %w", err)
+	}
+
+	formatted, err := optimizeGeneratedSource(buf.Bytes())
+	if err != nil {
+		_, _ = os.Stdout.Write(buf.Bytes()) // For issues debug.
+
+		return nil, fmt.Errorf("cannot optimize generated tests: %w", err)
+	}
+
+	return formatted, nil
+}
+
+func makeTemplateTestOption(opt templateOptionMeta) (templateTestOption, error) {
+	res := templateTestOption{
+		templateOptionMeta: opt,
+		Value:              "",
+		FuzzValue:          "",
+		Default:            "",
+		Comparable:         true,
+		CheckUnset:         false,
+	}
+
+	value, err := testValue(opt.OptionMeta)
+	if err != nil {
+		return res, err
+	}
+
+	switch opt.ParseType() {
+	case "string":
+		res.Value, res.FuzzValue = value, convertValue(opt.OptionMeta, "s")
+	case "bool":
+		res.Value, res.FuzzValue = value, convertValue(opt.OptionMeta, "b")
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		res.Value, res.FuzzValue = value, opt.Type+"(n)"
+	case "float32", "float64":
+		// NOTE: NaN is not equal to itself.
+		res.Value, res.FuzzValue, res.Comparable = value, opt.Type+"(x)", false
+	case "time.Duration":
+		res.Value, res.FuzzValue = value, "time461e464ebed9.Duration(n)"
+	default:
+		return res, nil
+	}
+
+	if opt.TagOption.Default == "" {
+		return res, nil
+	}
+
+	switch opt.ParseType() {
+	case "string":
+		res.Default = strconv.Quote(opt.TagOption.Default)
+	case "time.Duration":
+		duration, err := time.ParseDuration(opt.TagOption.Default)
+		if err != nil {
+			return res, fmt.Errorf("field `%s`: bad default value: %w", opt.Field, err)
+		}
+
+		res.Default = "time461e464ebed9.Duration(" + strconv.FormatInt(int64(duration), 10) + ")"
+	default:
+		res.Default = opt.Type + "(" + opt.TagOption.Default + ")"
+	}
+
+	return res, nil
+}
+
+// testValue returns the expression of the value for the setter test. The
+// value differs from the tag default, otherwise the test passes for a setter,
+// which does nothing. It is empty for types, which values are not generated.
+func testValue(opt OptionMeta) (string, error) {
+	def := opt.TagOption.Default
+
+	switch opt.ParseType() {
+	case "string":
+		value := "test-" + opt.Field
+		if value == def {
+			value += "-2"
+		}
+
+		return strconv.Quote(value), nil
+	case "bool":
+		value := true
+		if def != "" {
+			parsed, err := strconv.ParseBool(def)
+			if err != nil {
+				return "", fmt.Errorf("field `%s`: bad default value: %w", opt.Field, err)
+			}
+
+			value = !parsed
+		}
+
+		return strconv.FormatBool(value), nil
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		value := 7
+		if parsed, err := strconv.ParseInt(def, 0, 64); err == nil && parsed == int64(value) {
+			value++
+		}
+
+		return opt.Type + "(" + strconv.Itoa(value) + ")", nil
+	case "float32", "float64":
+		value := 0.5
+		if parsed, err := strconv.ParseFloat(def, 64); err == nil && parsed == value {
+			value++
+		}
+
+		return opt.Type + "(" + strconv.FormatFloat(value, 'g', -1, 64) + ")", nil
+	case "time.Duration":
+		value := 7
+		if parsed, err := time.ParseDuration(def); err == nil && parsed == time.Duration(value)*time.Second {
+			value++
+		}
+
+		return strconv.Itoa(value) + " * time461e464ebed9.Second", nil
+	}
+
+	return "", nil
+}
+
+// convertValue converts the value of the basic type to the named type of the
+// option.
+func convertValue(opt OptionMeta, value string) string {
+	if opt.ValueType == "" {
+		return value
+	}
+
+	return opt.Type + "(" + value + ")"
+}
+
+// testSetterCall returns the context for the applySetter template.
+func testSetterCall(tplContext map[string]interface{}, setterName, args string) map[string]interface{} {
+	return map[string]interface{}{
+		"style":           tplContext["style"],
+		"builderTypeName": tplContext["builderTypeName"],
+		"setterName":      setterName,
+		"args":            args,
+	}
+}
+
+// testGroupValues returns arguments of the group setter.
+func testGroupValues(options []templateTestOption) string {
+	values := make([]string, len(options))
+	for i, opt := range options {
+		values[i] = opt.Value
+		if opt.TagOption.Variadic {
+			values[i] = "[]" + opt.Type + "{" + opt.Value + "}"
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func hasRequiredRule(rules string) bool {
+	for _, rule := range splitValidationRules(rules) {
+		if rule == "dive" {
+			return false
+		}
+
+		if rule == "required" {
+			return true
+		}
+	}
+
+	return false
+}
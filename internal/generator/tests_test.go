@@ -0,0 +1,82 @@
+//nolint:exhaustruct
+package generator //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hasRequiredRule(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, hasRequiredRule("required"))
+	assert.True(t, hasRequiredRule("min=1,required"))
+	assert.False(t, hasRequiredRule(""))
+	assert.False(t, hasRequiredRule("omitempty,min=1"))
+	assert.False(t, hasRequiredRule("dive,required"))
+}
+
+func Test_makeTemplateTestOption(t *testing.T) {
+	t.Parallel()
+
+	makeOpt := func(typ, def string) templateOptionMeta {
+		return templateOptionMeta{
+			OptionMeta: OptionMeta{Field: "field", Type: typ, TagOption: TagOption{Default: def}},
+		}
+	}
+
+	t.Run("duration", func(t *testing.T) {
+		t.Parallel()
+
+		opt, err := makeTemplateTestOption(makeOpt("time.Duration", "1m"))
+		require.NoError(t, err)
+		assert.Equal(t, "7 * time461e464ebed9.Second", opt.Value)
+		assert.Equal(t, "time461e464ebed9.Duration(60000000000)", opt.Default)
+		assert.True(t, opt.Comparable)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		t.Parallel()
+
+		opt, err := makeTemplateTestOption(makeOpt("float64", "0.5"))
+		require.NoError(t, err)
+		assert.Equal(t, "float64(0.5)", opt.Default)
+		assert.Equal(t, "float64(1.5)", opt.Value)
+		assert.False(t, opt.Comparable)
+	})
+
+	t.Run("value_differs_from_default", func(t *testing.T) {
+		t.Parallel()
+
+		for _, test := range []struct{ typ, def, value string }{
+			{"bool", "", "true"},
+			{"bool", "true", "false"},
+			{"int", "3", "int(7)"},
+			{"int", "7", "int(8)"},
+			{"uint8", "0x7", "uint8(8)"},
+			{"string", "test-field", `"test-field-2"`},
+			{"time.Duration", "7s", "8 * time461e464ebed9.Second"},
+		} {
+			opt, err := makeTemplateTestOption(makeOpt(test.typ, test.def))
+			require.NoError(t, err)
+			assert.Equal(t, test.value, opt.Value, "%s with default %q", test.typ, test.def)
+		}
+	})
+
+	t.Run("unsupported_type", func(t *testing.T) {
+		t.Parallel()
+
+		opt, err := makeTemplateTestOption(makeOpt("*http.Client", ""))
+		require.NoError(t, err)
+		assert.Empty(t, opt.Value)
+	})
+
+	t.Run("bad_default", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := makeTemplateTestOption(makeOpt("time.Duration", "forever"))
+		require.Error(t, err)
+	})
+}
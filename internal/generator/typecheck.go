@@ -0,0 +1,400 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typedFile is the type-checked file with the options struct. Methods of the
+// nil typedFile report that types are unknown, so callers fall back to the
+// syntax.
+type typedFile struct {
+	pkg  *types.Package
+	info *types.Info
+	file *ast.File
+}
+
+// errForeignStruct reports that the options type is a struct of another
+// package, like `type Options pkg.Options`. Fields of such structs are
+// resolved by the syntax.
+var errForeignStruct = errors.New("the options struct is declared in another package")
+
+// findTypedStruct loads the package of filePath with the type checker and
+// returns the struct typeName. Only files of the current build are used.
+// Type errors are ignored: usually the generated file is missing or outdated,
+// but types of the struct fields are known anyway.
+func findTypedStruct(
+	fset *token.FileSet,
+	filePath, typeName string,
+) (*typedFile, *ast.TypeSpec, *ast.StructType, error) {
+	typed, files, err := loadTypedPackage(fset, filePath, nil, "")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot type-check the package: %w", err)
+	}
+
+	for _, file := range files {
+		typeSpec, structType := findStructDecl(file, typeName)
+		if structType != nil {
+			return typed.withFile(file), typeSpec, structType, nil
+		}
+
+		if declaresType(file, typeName) {
+			return nil, nil, nil, errForeignStruct
+		}
+	}
+
+	return nil, nil, nil, fmt.Errorf("cannot find target struct `%s`", typeName)
+}
+
+// findTypedFunc loads the package of filePath with the type checker and
+// returns the function funcName.
+func findTypedFunc(fset *token.FileSet, filePath, funcName string) (*typedFile, *ast.FuncDecl, error) {
+	typed, files, err := loadTypedPackage(fset, filePath, nil, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot type-check the package: %w", err)
+	}
+
+	file, decl := findFuncDecl(files, funcName)
+	if decl == nil {
+		return nil, nil, fmt.Errorf("cannot find function `%s`", funcName)
+	}
+
+	return typed.withFile(file), decl, nil
+}
+
+// checkSourceFile type-checks src as the file filePath of the package of its
+// directory. The file may not exist. Files of the package, which declare
+// typeName, are left out: usually it is the generated file, which declares
+// the struct of src.
+func checkSourceFile(fset *token.FileSet, filePath string, src []byte, typeName string) (*typedFile, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	typed, files, err := loadTypedPackage(fset, absPath, src, typeName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot type-check the package: %w", err)
+	}
+
+	for _, file := range files {
+		if fset.Position(file.Pos()).Filename == absPath {
+			return typed.withFile(file), nil
+		}
+	}
+
+	return nil, errors.New("source file is not a part of the package")
+}
+
+// loadTypedPackage loads the package of filePath and type-checks its files.
+// overlay, when it is not nil, is the content of filePath instead of the file
+// on disk. Other files of the package, which declare skipType, are left out.
+func loadTypedPackage(
+	fset *token.FileSet,
+	filePath string,
+	overlay []byte,
+	skipType string,
+) (*typedFile, []*ast.File, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// NOTE: dependencies are imported from the export data. Loading them
+	// from the source is too slow and packages.Load stops the process, when
+	// the export data of a dependency is missing.
+	cfg := &packages.Config{ //nolint:exhaustruct
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports |
+			packages.NeedDeps | packages.NeedExportFile,
+		Dir:   filepath.Dir(absPath),
+		Tests: strings.HasSuffix(absPath, "_test.go"),
+	}
+
+	if overlay != nil {
+		cfg.Overlay = map[string][]byte{absPath: overlay}
+	}
+
+	pkgs, err := packages.Load(cfg, "file="+absPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load package: %w", err)
+	}
+
+	idx := slices.IndexFunc(pkgs, func(pkg *packages.Package) bool {
+		return slices.Contains(pkg.GoFiles, absPath)
+	})
+	if idx < 0 {
+		return nil, nil, fmt.Errorf("cannot load package of %s", filePath)
+	}
+
+	pkg := pkgs[idx]
+
+	files := make([]*ast.File, 0, len(pkg.GoFiles))
+	for _, filename := range pkg.GoFiles {
+		var src any
+		if filename == absPath && overlay != nil {
+			src = overlay
+		}
+
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot parse file: %w", err)
+		}
+
+		if filename != absPath && skipType != "" && declaresType(file, skipType) {
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	info := &types.Info{ //nolint:exhaustruct
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+
+	conf := types.Config{ //nolint:exhaustruct
+		Importer: importer.ForCompiler(fset, "gc", exportLookup(pkg)),
+		Error:    func(error) {},
+	}
+
+	typesPkg, _ := conf.Check(pkg.PkgPath, fset, files, info)
+
+	return &typedFile{pkg: typesPkg, info: info, file: nil}, files, nil
+}
+
+// withFile returns types of the package for the file. Types of other
+// packages are qualified by imports of the file.
+func (t *typedFile) withFile(file *ast.File) *typedFile {
+	return &typedFile{pkg: t.pkg, info: t.info, file: file}
+}
+
+// exportLookup opens the export data of dependencies of the package. The
+// export data is read by the importer of the toolchain, which built the
+// generator, so it is compatible with the go command of the same version.
+func exportLookup(pkg *packages.Package) importer.Lookup {
+	return func(importPath string) (io.ReadCloser, error) {
+		dep, ok := pkg.Imports[importPath]
+		if !ok || dep.ExportFile == "" {
+			return nil, fmt.Errorf("no export data for %s", importPath)
+		}
+
+		return os.Open(dep.ExportFile)
+	}
+}
+
+func declaresType(file *ast.File, typeName string) bool {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			if spec.(*ast.TypeSpec).Name.Name == typeName { //nolint:forcetypeassert
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func findStructDecl(file *ast.File, typeName string) (*ast.TypeSpec, *ast.StructType) {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != typeName {
+				continue
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				return nil, nil
+			}
+
+			return typeSpec, structType
+		}
+	}
+
+	return nil, nil
+}
+
+// typeOf returns the type of the expression or nil, when it is unknown.
+func (t *typedFile) typeOf(expr ast.Expr) types.Type {
+	if t == nil {
+		return nil
+	}
+
+	typ := t.info.TypeOf(expr)
+	if arrayType, ok := expr.(*ast.ArrayType); ok && typ == nil && arrayType.Len == nil {
+		// NOTE: the slice of the variadic parameter is not a part of the
+		// checked syntax, but its element is.
+		if elem := t.typeOf(arrayType.Elt); elem != nil {
+			return types.NewSlice(elem)
+		}
+	}
+
+	if typ == nil || !isValidType(typ) {
+		return nil
+	}
+
+	return typ
+}
+
+// typeString returns the type of the expression qualified by imports of the
+// file. The syntax is used, when the type is unknown.
+func (t *typedFile) typeString(expr ast.Expr) string {
+	if typ := t.typeOf(expr); typ != nil {
+		return types.TypeString(typ, t.qualifier)
+	}
+
+	return types.ExprString(expr)
+}
+
+// isValidType reports whether the type and types of its elements are known.
+func isValidType(typ types.Type) bool {
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return typ.Kind() != types.Invalid
+	case *types.Pointer:
+		return isValidType(typ.Elem())
+	case *types.Slice:
+		return isValidType(typ.Elem())
+	case *types.Array:
+		return isValidType(typ.Elem())
+	case *types.Chan:
+		return isValidType(typ.Elem())
+	case *types.Map:
+		return isValidType(typ.Key()) && isValidType(typ.Elem())
+	case *types.Named:
+		// NOTE: elements of named types are not checked, they can refer to
+		// the type itself.
+		basic, ok := typ.Underlying().(*types.Basic)
+
+		return !ok || basic.Kind() != types.Invalid
+	}
+
+	return true
+}
+
+// qualifier qualifies types of other packages by names of imports of the
+// file.
+func (t *typedFile) qualifier(pkg *types.Package) string {
+	if pkg == t.pkg {
+		return ""
+	}
+
+	for _, imp := range t.file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || importPath != pkg.Path() {
+			continue
+		}
+
+		if imp.Name != nil && imp.Name.Name != "_" {
+			if imp.Name.Name == "." {
+				return ""
+			}
+
+			return imp.Name.Name
+		}
+	}
+
+	return pkg.Name()
+}
+
+// sliceElemType returns the element type of the slice type expr. Named slice
+// types are resolved by the type checker, when the file is type-checked.
+func sliceElemType(file *ast.File, typed *typedFile, expr ast.Expr, packageStore *PackageStore) (string, error) {
+	typ := typed.typeOf(expr)
+	if _, ok := expr.(*ast.ArrayType); ok || typ == nil {
+		return extractSliceElemType(file, expr, packageStore)
+	}
+
+	slice, ok := typ.Underlying().(*types.Slice)
+	if !ok {
+		return "", errIsNotSlice
+	}
+
+	if !isValidType(slice.Elem()) {
+		return extractSliceElemType(file, expr, packageStore)
+	}
+
+	return types.TypeString(slice.Elem(), typed.qualifier), nil
+}
+
+// valueType returns the type, which values of typ are parsed as. Named types
+// of supported basic types are parsed as the basic types.
+func valueType(typ types.Type, fieldType string) string {
+	if typ == nil {
+		return ""
+	}
+
+	if _, err := valueParseExpr(fieldType, "raw"); err == nil {
+		return ""
+	}
+
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return ""
+	}
+
+	if obj := named.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration" {
+		return "time.Duration"
+	}
+
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok {
+		return ""
+	}
+
+	if _, err := valueParseExpr(basic.Name(), "raw"); err != nil {
+		return ""
+	}
+
+	return basic.Name()
+}
+
+// typeKind returns the kind of the underlying type of typ.
+func typeKind(typ types.Type) Kind {
+	// NOTE: the underlying type of the type parameter is its constraint, but
+	// the kind of the type argument is not known.
+	if _, ok := typ.(*types.TypeParam); typ == nil || ok {
+		return KindUnknown
+	}
+
+	switch typ.Underlying().(type) {
+	case *types.Slice:
+		return KindSlice
+	case *types.Map:
+		return KindMap
+	case *types.Signature:
+		return KindFunc
+	case *types.Chan:
+		return KindChan
+	case *types.Interface:
+		return KindInterface
+	case *types.Pointer:
+		return KindPointer
+	}
+
+	return KindUnknown
+}
@@ -0,0 +1,207 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/internal/ctype"
+	"github.com/kazhuravlev/options-gen/internal/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOptionSpecTypeChecked(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod": "module example.com/typed\n\ngo 1.24\n",
+		"types.go": `package typed
+
+type (
+	Port    int
+	Name    string
+	Verbose bool
+	Names   []Name
+	Handler func()
+)
+`,
+		"ignored.go": `//go:build ignore
+
+package typed
+
+type Options struct {
+	other int
+}
+`,
+		"options.go": `package typed
+
+import (
+	nethttp "net/http"
+	"time"
+)
+
+type Timeout = time.Duration
+
+type Options struct {
+	port    Port    ` + "`default:\"8080\"`" + `
+	name    Name    ` + "`default:\"svc\"`" + `
+	verbose Verbose
+	timeout Timeout ` + "`default:\"5s\"`" + `
+	names   Names   ` + "`option:\"variadic=true\"`" + `
+	handler Handler
+	client  nethttp.RoundTripper
+	headers nethttp.Header
+}
+`,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), ctype.DefaultPermission))
+	}
+
+	res, err := generator.GetOptionSpec(filepath.Join(dir, "options.go"), "Options", "default", false, nil)
+	require.NoError(t, err)
+
+	type option struct {
+		Type      string
+		ValueType string
+		Kind      generator.Kind
+	}
+
+	options := make(map[string]option, len(res.Spec.Options))
+	for _, opt := range res.Spec.Options {
+		options[opt.Field] = option{Type: opt.Type, ValueType: opt.ValueType, Kind: opt.Kind}
+	}
+
+	assert.Equal(t, map[string]option{
+		"port":    {Type: "Port", ValueType: "int"},
+		"name":    {Type: "Name", ValueType: "string"},
+		"verbose": {Type: "Verbose", ValueType: "bool"},
+		"timeout": {Type: "Timeout", ValueType: "time.Duration"},
+		"names":   {Type: "Name", ValueType: "string"},
+		"handler": {Type: "Handler", Kind: generator.KindFunc},
+		"client":  {Type: "nethttp.RoundTripper", Kind: generator.KindInterface},
+		"headers": {Type: "nethttp.Header", Kind: generator.KindMap},
+	}, options)
+}
+
+func TestGetFuncOptionSpecTypeChecked(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod": "module example.com/typed\n\ngo 1.24\n",
+		"dial.go": `package typed
+
+import "net/http"
+
+type (
+	Port int
+	Name string
+)
+
+//options-gen:func mandatory=1
+func Dial(addr string, port Port, client http.RoundTripper, names ...Name) error {
+	return nil
+}
+`,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), ctype.DefaultPermission))
+	}
+
+	res, _, err := generator.GetFuncOptionSpec(filepath.Join(dir, "dial.go"), "Dial", "default", false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, res.Warnings)
+
+	kinds := make(map[string][2]string, len(res.Spec.Options))
+	for _, opt := range res.Spec.Options {
+		kinds[opt.Field] = [2]string{opt.ValueType, string(opt.Kind)}
+	}
+
+	assert.Equal(t, map[string][2]string{
+		"addr":   {"", ""},
+		"port":   {"int", ""},
+		"client": {"", string(generator.KindInterface)},
+		"names":  {"", string(generator.KindSlice)},
+	}, kinds)
+}
+
+func TestGetSourceOptionSpecTypeChecked(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod":   "module example.com/typed\n\ngo 1.24\n",
+		"types.go": "package typed\n\ntype Port int\n",
+		// NOTE: the output of the previous run declares the struct too.
+		"options_generated.go": "package typed\n\ntype Options struct{ other string }\n",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), ctype.DefaultPermission))
+	}
+
+	const src = `package typed
+
+import "time"
+
+type Options struct {
+	port    Port
+	timeout *time.Duration
+}
+`
+
+	res, _, err := generator.GetSourceOptionSpec([]byte(src), "Options", dir, "default", false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, res.Warnings)
+	require.Len(t, res.Spec.Options, 2)
+	assert.Equal(t, "int", res.Spec.Options[0].ValueType)
+	assert.Equal(t, generator.KindPointer, res.Spec.Options[1].Kind)
+}
+
+func TestGetOptionSpecTypeCheckError(t *testing.T) {
+	// NOTE: the package cannot be loaded without the go command.
+	t.Setenv("PATH", "")
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod":     "module example.com/typed\n\ngo 1.24\n",
+		"options.go": "package typed\n\ntype Options struct {\n\tport int\n}\n",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), ctype.DefaultPermission))
+	}
+
+	_, err := generator.GetOptionSpec(filepath.Join(dir, "options.go"), "Options", "default", false, nil)
+	require.ErrorContains(t, err, "cannot type-check the package")
+
+	_, _, err = generator.GetSourceOptionSpec([]byte("package typed\n\ntype Options struct{ port int }\n"),
+		"Options", dir, "default", false, nil)
+	require.ErrorContains(t, err, "cannot type-check the package")
+}
+
+func TestGetOptionSpecForeignStruct(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod":         "module example.com/typed\n\ngo 1.24\n",
+		"pkg/options.go": "package pkg\n\ntype Options struct {\n\tPort int `option:\"mandatory\"`\n}\n",
+		"options.go": `package typed
+
+import "example.com/typed/pkg"
+
+type Options pkg.Options
+`,
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), ctype.DefaultPermission))
+	}
+
+	// NOTE: fields of structs of other packages are resolved by the syntax.
+	res, err := generator.GetOptionSpec(filepath.Join(dir, "options.go"), "Options", "default", false, nil)
+	require.NoError(t, err)
+	require.Len(t, res.Spec.Options, 1)
+	assert.Equal(t, "int", res.Spec.Options[0].Type)
+
+	for _, warning := range res.Warnings {
+		assert.NotContains(t, warning, "type-check")
+	}
+}
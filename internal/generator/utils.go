@@ -509,6 +509,41 @@ func isPublic(fieldName string) bool {
 	return char != utf8.RuneError && unicode.IsUpper(char)
 }
 
+// optionsinfoPkgPath is the import path of the package with runtime types of
+// the generated code.
+const optionsinfoPkgPath = "github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+
+// isSourcesType reports whether expr is optionsinfo.Sources imported by file.
+func isSourcesType(file *ast.File, expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Sources" {
+		return false
+	}
+
+	pkgIdent, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || importPath != optionsinfoPkgPath {
+			continue
+		}
+
+		name := path.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+
+		if name == pkgIdent.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func checkDefaultValue(fieldType string, tag string) error {
 	var err error
 	switch fieldType {
@@ -543,6 +578,121 @@ func checkDefaultValue(fieldType string, tag string) error {
 	return nil
 }
 
+// valueParseExpr returns an expression that parses string variable src into
+// the value of fieldType. Supported types are the same as for checkDefaultValue.
+func valueParseExpr(fieldType string, src string) (string, error) {
+	switch fieldType {
+	case "int", "int8", "int16", "int32", "int64":
+		return "strconv461e464ebed9.ParseInt(" + src + ", 10, " + typeBitSize(fieldType) + ")", nil
+
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return "strconv461e464ebed9.ParseUint(" + src + ", 10, " + typeBitSize(fieldType) + ")", nil
+
+	case "float32", "float64":
+		return "strconv461e464ebed9.ParseFloat(" + src + ", " + typeBitSize(fieldType) + ")", nil
+
+	case "time.Duration":
+		return "time461e464ebed9.ParseDuration(" + src + ")", nil
+
+	case "bool":
+		return "strconv461e464ebed9.ParseBool(" + src + ")", nil
+
+	case "string":
+		return src + ", error(nil)", nil
+
+	default:
+		return "", fmt.Errorf("unsupported type `%s`", fieldType)
+	}
+}
+
+func typeBitSize(fieldType string) string {
+	bitSize := strings.TrimLeft(fieldType, "uintfloa")
+	if bitSize == "" {
+		return "0"
+	}
+
+	return bitSize
+}
+
+// stringerExprs returns expressions, which represents the field value in
+// String and LogValue methods accordingly.
+func stringerExprs(opt OptionMeta) (string, string) {
+	value := "o." + opt.Field
+
+	var summary string
+	switch {
+	case opt.TagOption.Secret:
+		summary = "redact461e464ebed9.Mask"
+	case opt.TagOption.Variadic, opt.Kind == KindSlice, opt.Kind == KindMap,
+		strings.HasPrefix(opt.Type, "[]"), strings.HasPrefix(opt.Type, "map["):
+		summary = "redact461e464ebed9.Items(len(" + value + "))"
+	case opt.Kind == KindFunc, opt.Kind == KindChan, strings.HasPrefix(opt.Type, "func("), strings.HasPrefix(opt.Type, "chan "),
+		strings.HasPrefix(opt.Type, "chan<-"), strings.HasPrefix(opt.Type, "<-chan"):
+		summary = "redact461e464ebed9.Set(" + value + " != nil)"
+	case opt.Kind == KindPointer, opt.Kind == KindInterface, strings.HasPrefix(opt.Type, "*"),
+		strings.HasPrefix(opt.Type, "interface{"), opt.Type == "any", opt.Type == "error":
+		// NOTE: pointers are printed as addresses and dynamic values of
+		// interfaces, like loggers and clients, are not meant to be printed.
+		summary = "redact461e464ebed9.Set(" + value + " != nil)"
+	default:
+		return "fmt461e464ebed9.Sprint(" + value + ")", value
+	}
+
+	return summary, summary
+}
+
+// splitWords splits identifier to lowercase words: `maxDBConns` => [max db conns].
+func splitWords(name string) []string {
+	runes := []rune(name)
+	words := make([]string, 0, 1)
+	wordStart := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		lowerToUpper := (unicode.IsLower(prev) || unicode.IsDigit(prev)) && unicode.IsUpper(cur)
+		acronymEnd := unicode.IsUpper(prev) && unicode.IsUpper(cur) &&
+			i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+		if lowerToUpper || acronymEnd || cur == '_' || cur == '-' {
+			if wordStart < i {
+				words = append(words, strings.ToLower(string(runes[wordStart:i])))
+			}
+
+			wordStart = i
+			if cur == '_' || cur == '-' {
+				wordStart++
+			}
+		}
+	}
+
+	if wordStart < len(runes) {
+		words = append(words, strings.ToLower(string(runes[wordStart:])))
+	}
+
+	return words
+}
+
+func kebabCase(name string) string {
+	return strings.Join(splitWords(name), "-")
+}
+
+func snakeCase(name string) string {
+	return strings.Join(splitWords(name), "_")
+}
+
+// commentText converts formatted docstring back to the plain one-line text.
+func commentText(docstring string) string {
+	lines := strings.Split(docstring, "\n")
+	words := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(strings.TrimPrefix(line, "//"))
+		if line != "" {
+			words = append(words, line)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
 func normalizeTypeName(typeName string) string {
 	if idx := strings.LastIndex(typeName, "."); idx > -1 {
 		typeName = typeName[idx+1:]
@@ -714,18 +864,14 @@ func parseTag(tag *ast.BasicLit, fieldName string, tagName string) (TagOption, [
 	tagValue := reflect.StructTag(strings.Trim(tag.Value, "`"))
 	tagOpt.GoValidator = tagValue.Get("validate")
 	tagOpt.Default = tagValue.Get(tagName)
+	tagOpt.Env = tagValue.Get("env")
+	tagOpt.Key = tagValue.Get("key")
 
 	var warnings []string
 	optionTag := tagValue.Get("option")
 	for len(optionTag) > 0 {
-		nextComma := strings.IndexByte(optionTag, ',')
-		opt := optionTag
-		if nextComma >= 0 {
-			opt = optionTag[:nextComma]
-			optionTag = optionTag[nextComma+1:]
-		} else {
-			optionTag = ""
-		}
+		var opt string
+		opt, optionTag = cutTagOption(optionTag)
 
 		optName, optValue, _ := strings.Cut(opt, "=")
 
@@ -762,6 +908,22 @@ func parseTag(tag *ast.BasicLit, fieldName string, tagName string) (TagOption, [
 			tagOpt.Variadic = val
 			tagOpt.VariadicIsSet = true
 
+		case "secret":
+			tagOpt.Secret = true
+
+		case "alias":
+			tagOpt.Aliases = append(tagOpt.Aliases, optValue)
+
+		case "group":
+			tagOpt.Group = optValue
+
+		case "standalone":
+			tagOpt.Standalone = true
+
+		case "deprecated":
+			tagOpt.Deprecated = optValue
+			warnings = append(warnings, deprecatedOptionWarning(fieldName, optValue))
+
 		case "-":
 			tagOpt.Skip = true
 		}
@@ -770,6 +932,24 @@ func parseTag(tag *ast.BasicLit, fieldName string, tagName string) (TagOption, [
 	return tagOpt, warnings
 }
 
+// cutTagOption cuts the first option of the option tag. The value in single
+// quotes can contain commas: deprecated='use A, then B'.
+func cutTagOption(tag string) (string, string) {
+	name, value, ok := strings.Cut(tag, "='")
+	if ok && !strings.Contains(name, ",") {
+		if end := strings.IndexByte(value, '\''); end >= 0 {
+			rest := value[end+1:]
+			if rest == "" || rest[0] == ',' {
+				return name + "=" + value[:end], strings.TrimPrefix(rest, ",")
+			}
+		}
+	}
+
+	opt, rest, _ := strings.Cut(tag, ",")
+
+	return opt, rest
+}
+
 func deprecatedRequiredWarning(fieldName string) string {
 	return "Deprecated: use `option:\"mandatory\"` instead for field `" + fieldName +
 		"` to force the passing option in the constructor argument\n"
@@ -780,6 +960,10 @@ func deprecatedNotEmptyWarning(fieldName string) string {
 		fieldName + "` content\n"
 }
 
+func deprecatedOptionWarning(fieldName, message string) string {
+	return "Warning: option `" + fieldName + "` is deprecated: " + message
+}
+
 func parseVariadicWarning(fieldName string, err error) string {
 	return "Error: parse variadic for the field " + fieldName + " failed: " + err.Error() + "\n"
 }
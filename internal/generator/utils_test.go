@@ -125,6 +125,71 @@ func Test_checkDefaultValue(t *testing.T) {
 	}
 }
 
+func Test_valueParseExpr(t *testing.T) {
+	cases := []struct {
+		t        string
+		expected string
+	}{
+		{t: "int", expected: "strconv461e464ebed9.ParseInt(raw, 10, 0)"},
+		{t: "int8", expected: "strconv461e464ebed9.ParseInt(raw, 10, 8)"},
+		{t: "int64", expected: "strconv461e464ebed9.ParseInt(raw, 10, 64)"},
+		{t: "uint", expected: "strconv461e464ebed9.ParseUint(raw, 10, 0)"},
+		{t: "uint16", expected: "strconv461e464ebed9.ParseUint(raw, 10, 16)"},
+		{t: "float32", expected: "strconv461e464ebed9.ParseFloat(raw, 32)"},
+		{t: "float64", expected: "strconv461e464ebed9.ParseFloat(raw, 64)"},
+		{t: "bool", expected: "strconv461e464ebed9.ParseBool(raw)"},
+		{t: "time.Duration", expected: "time461e464ebed9.ParseDuration(raw)"},
+		{t: "string", expected: "raw, error(nil)"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.t, func(t *testing.T) {
+			expr, err := valueParseExpr(tt.t, "raw")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, expr)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := valueParseExpr("[]string", "raw")
+		require.Error(t, err)
+	})
+}
+
+func Test_kebabCase(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{in: "timeout", expected: "timeout"},
+		{in: "maxAttempts", expected: "max-attempts"},
+		{in: "httpClient", expected: "http-client"},
+		{in: "maxDBConns", expected: "max-db-conns"},
+		{in: "URL", expected: "url"},
+		{in: "PublicField", expected: "public-field"},
+		{in: "retry2Times", expected: "retry2-times"},
+		{in: "snake_case", expected: "snake-case"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.expected, kebabCase(tt.in))
+		})
+	}
+}
+
+func Test_snakeCase(t *testing.T) {
+	assert.Equal(t, "max_attempts", snakeCase("maxAttempts"))
+	assert.Equal(t, "http_client", snakeCase("httpClient"))
+	assert.Equal(t, "max_db_conns", snakeCase("maxDBConns"))
+}
+
+func Test_commentText(t *testing.T) {
+	assert.Equal(t, "", commentText(""))
+	assert.Equal(t, "single line", commentText("// single line"))
+	assert.Equal(t, "multi line comment", commentText("// multi\n// line\n// \n// comment"))
+}
+
 func Test_normalizeTypeName(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -253,6 +318,26 @@ var _ = Pi
 	require.NotContains(t, gotStr, `"io"`)
 }
 
+func Test_optimizeGeneratedSource_PrunedImportsDoNotSplitGroups(t *testing.T) {
+	src := []byte(`package testcase
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"os"
+)
+
+var _ = fmt.Sprintf
+var _ = strings.Builder{}
+var _ = os.Args
+`)
+
+	got, err := optimizeGeneratedSource(src)
+	require.NoError(t, err)
+	require.Contains(t, string(got), "import (\n\t\"fmt\"\n\t\"os\"\n\t\"strings\"\n)")
+}
+
 func Test_parseModulePath(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -581,6 +666,51 @@ func Test_parseTag(t *testing.T) {
 				"Error: parse variadic for the field fieldName failed: strconv.ParseBool: parsing \"bad\": invalid syntax\n",
 			},
 		},
+		{
+			name:      "env",
+			tag:       &ast.BasicLit{Value: "`env:\"APP_TIMEOUT\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				IsRequired:    false,
+				GoValidator:   "",
+				Default:       "",
+				Variadic:      false,
+				VariadicIsSet: false,
+				Skip:          false,
+				Env:           "APP_TIMEOUT",
+			},
+		},
+		{
+			name:      "key",
+			tag:       &ast.BasicLit{Value: "`key:\"listen\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				IsRequired:    false,
+				GoValidator:   "",
+				Default:       "",
+				Variadic:      false,
+				VariadicIsSet: false,
+				Skip:          false,
+				Key:           "listen",
+			},
+		},
+		{
+			name:      "secret",
+			tag:       &ast.BasicLit{Value: "`option:\"mandatory,secret\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				IsRequired:    true,
+				GoValidator:   "",
+				Default:       "",
+				Variadic:      false,
+				VariadicIsSet: false,
+				Skip:          false,
+				Secret:        true,
+			},
+		},
 		{
 			name:      "name replace",
 			tag:       &ast.BasicLit{Value: "`option:\"name=Some\"`"},
@@ -596,6 +726,49 @@ func Test_parseTag(t *testing.T) {
 				Name:          "Some",
 			},
 		},
+		{
+			name:      "aliases",
+			tag:       &ast.BasicLit{Value: "`option:\"name=Some,alias=Old,alias=Older\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				Name:    "Some",
+				Aliases: []string{"Old", "Older"},
+			},
+		},
+		{
+			name:      "deprecated",
+			tag:       &ast.BasicLit{Value: "`option:\"deprecated=use WithOther\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				Deprecated: "use WithOther",
+			},
+			wantWarnings: []string{"Warning: option `fieldName` is deprecated: use WithOther"},
+		},
+		{
+			name:      "deprecated_quoted",
+			tag:       &ast.BasicLit{Value: "`option:\"secret,deprecated='use A, then B',alias=Old\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				Secret:     true,
+				Deprecated: "use A, then B",
+				Aliases:    []string{"Old"},
+			},
+			wantWarnings: []string{"Warning: option `fieldName` is deprecated: use A, then B"},
+		},
+		{
+			name:      "deprecated_apostrophe",
+			tag:       &ast.BasicLit{Value: "`option:\"deprecated=don't use,secret\"`"},
+			fieldName: "fieldName",
+			tagName:   "default",
+			wantOption: TagOption{
+				Secret:     true,
+				Deprecated: "don't use",
+			},
+			wantWarnings: []string{"Warning: option `fieldName` is deprecated: don't use"},
+		},
 	}
 
 	for _, tc := range testCases {
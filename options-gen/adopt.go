@@ -0,0 +1,71 @@
+package optionsgen
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kazhuravlev/options-gen/internal/ctype"
+	"github.com/kazhuravlev/options-gen/internal/generator"
+)
+
+// AdoptedSetter is a hand-written setter of Field, which is replaced by the
+// generated one.
+type AdoptedSetter struct {
+	Name  string
+	Field string
+}
+
+type AdoptResult struct {
+	// Files are the rewritten files.
+	Files    []string
+	Setters  []AdoptedSetter
+	Warnings []string
+}
+
+// Adopt converts hand-written functional options of the struct into tags of
+// its fields and removes the setters, which generation with opts reproduces.
+// opts must match the options used for generation: struct name, out prefix,
+// naming, setter prefix and the option type name.
+func Adopt(opts Options) (AdoptResult, error) {
+	outOptionTypeName, err := resolveOutOptionTypeName(opts.structName, opts.outOptionTypeName)
+	if err != nil {
+		return AdoptResult{}, err
+	}
+
+	if !setterPrefixPattern.MatchString(opts.setterPrefix) {
+		return AdoptResult{}, fmt.Errorf("setterPrefix must be empty or an exported identifier")
+	}
+
+	adopted, err := generator.Adopt(opts.inFilename, generator.NewOptions(
+		generator.WithOptionsStructName(opts.structName),
+		generator.WithPrefix(opts.outPrefix),
+		generator.WithOptionTypeName(outOptionTypeName),
+		generator.WithNaming(generator.Naming(opts.naming)),
+		generator.WithInitialisms(opts.initialisms),
+		generator.WithSetterPrefix(opts.setterPrefix),
+		generator.WithStyle(generator.Style(opts.style)),
+	))
+	if err != nil {
+		return AdoptResult{}, fmt.Errorf("cannot adopt setters: %w", err)
+	}
+
+	res := AdoptResult{
+		Files:    make([]string, 0, len(adopted.Files)),
+		Setters:  make([]AdoptedSetter, len(adopted.Setters)),
+		Warnings: adopted.Warnings,
+	}
+
+	for i, setter := range adopted.Setters {
+		res.Setters[i] = AdoptedSetter{Name: setter.Name, Field: setter.Field}
+	}
+
+	for _, file := range adopted.Files {
+		if err := os.WriteFile(file.Filename, file.Content, ctype.DefaultPermission); err != nil {
+			return res, fmt.Errorf("cannot write result: %w", err)
+		}
+
+		res.Files = append(res.Files, file.Filename)
+	}
+
+	return res, nil
+}
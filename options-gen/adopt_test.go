@@ -0,0 +1,50 @@
+package optionsgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdopt(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "options.go")
+	require.NoError(t, os.WriteFile(filename, []byte(`package testcase
+
+type Options struct {
+	name string
+}
+
+func WithName(name string) OptOptionsSetter {
+	return func(o *Options) { o.name = name }
+}
+
+type OptOptionsSetter func(o *Options)
+`), 0o600))
+
+	res, err := optionsgen.Adopt(optionsgen.NewOptions(
+		optionsgen.WithInFilename(filename),
+		optionsgen.WithStructName("Options"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filename}, res.Files)
+	assert.Equal(t, []optionsgen.AdoptedSetter{{Name: "WithName", Field: "name"}}, res.Setters)
+	assert.Empty(t, res.Warnings)
+
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, "package testcase\n\ntype Options struct {\n\tname string\n}\n", string(content))
+
+	_, err = optionsgen.Adopt(optionsgen.NewOptions(
+		optionsgen.WithInFilename(filename),
+		optionsgen.WithStructName("Options"),
+		optionsgen.WithSetterPrefix("with"),
+	))
+	require.Error(t, err)
+}
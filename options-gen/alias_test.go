@@ -0,0 +1,21 @@
+package optionsgen_test
+
+import (
+	"testing"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-33-alias"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsAlias(t *testing.T) {
+	opts := testcase.NewOptions[string](":8080",
+		testcase.WithTimeout[string](3),
+		testcase.WithServers[string]("a", "b"),
+		testcase.WithPayload("payload"),
+	)
+
+	assert.True(t, opts.IsSet(testcase.Fieldtimeout))
+	assert.True(t, opts.IsSet(testcase.Fieldhosts))
+	assert.True(t, opts.IsSet(testcase.Fieldvalue))
+	assert.False(t, opts.IsSet(testcase.Fieldretries))
+}
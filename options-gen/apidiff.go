@@ -0,0 +1,130 @@
+package optionsgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kazhuravlev/options-gen/internal/generator"
+)
+
+type APIChangeKind string
+
+const (
+	APIChangeCompatible  APIChangeKind = "compatible"
+	APIChangeBehavioural APIChangeKind = "behavioural"
+	APIChangeBreaking    APIChangeKind = "breaking"
+)
+
+// APIChange is a change of the generated API. Field is empty for changes of
+// group setters and of the constructor.
+type APIChange struct {
+	Kind    APIChangeKind `json:"kind"`
+	Field   string        `json:"field,omitempty"`
+	Message string        `json:"message"`
+}
+
+type APIDiffFormat string
+
+const (
+	APIDiffFormatText APIDiffFormat = "text"
+	APIDiffFormatJSON APIDiffFormat = "json"
+)
+
+// APIDiff compares the API generated for the struct in baseInFilename with
+// the API generated for the struct in the inFilename of opts. Both revisions
+// are read with the same options: struct name, defaults, naming and style.
+func APIDiff(opts Options, baseInFilename string) ([]APIChange, error) {
+	base, err := apiOptions(opts, baseInFilename)
+	if err != nil {
+		return nil, fmt.Errorf("base: %w", err)
+	}
+
+	current, err := apiOptions(opts, opts.inFilename)
+	if err != nil {
+		return nil, fmt.Errorf("current: %w", err)
+	}
+
+	changes := generator.DiffAPI(base, current)
+
+	res := make([]APIChange, len(changes))
+	for i, change := range changes {
+		res[i] = APIChange{
+			Kind:    APIChangeKind(change.Kind),
+			Field:   change.Field,
+			Message: change.Message,
+		}
+	}
+
+	return res, nil
+}
+
+func apiOptions(opts Options, inFilename string) (generator.Options, error) {
+	var zero generator.Options
+
+	if !setterPrefixPattern.MatchString(opts.setterPrefix) {
+		return zero, fmt.Errorf("setterPrefix must be empty or an exported identifier")
+	}
+
+	tagName, _, _ := resolveDefaults(opts.defaults, opts.structName)
+
+	spec, err := generator.GetOptionSpec(inFilename, opts.structName, tagName, opts.allVariadic, opts.exclude)
+	if err != nil {
+		return zero, fmt.Errorf("cannot get options spec: %w", err)
+	}
+
+	if _, err := resolveDefaultsFile(opts.defaults, spec.Spec.Options); err != nil {
+		return zero, err
+	}
+
+	return generator.NewOptions(
+		generator.WithOptionsStructName(opts.structName),
+		generator.WithSpec(&spec.Spec),
+		generator.WithPrefix(opts.outPrefix),
+		generator.WithNaming(generator.Naming(opts.naming)),
+		generator.WithInitialisms(opts.initialisms),
+		generator.WithSetterPrefix(opts.setterPrefix),
+		generator.WithStyle(generator.Style(opts.style)),
+	), nil
+}
+
+// HasBreakingChanges reports whether any of changes is breaking.
+func HasBreakingChanges(changes []APIChange) bool {
+	for _, change := range changes {
+		if change.Kind == APIChangeBreaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FormatAPIChanges renders changes as human-readable lines or JSON.
+func FormatAPIChanges(changes []APIChange, format APIDiffFormat) ([]byte, error) {
+	switch format {
+	case APIDiffFormatJSON:
+		if changes == nil {
+			changes = []APIChange{}
+		}
+
+		res, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal changes: %w", err)
+		}
+
+		return append(res, '\n'), nil
+	case APIDiffFormatText:
+		if len(changes) == 0 {
+			return []byte("no changes\n"), nil
+		}
+
+		buf := new(bytes.Buffer)
+		for _, change := range changes {
+			fmt.Fprintf(buf, "%s: %s\n", change.Kind, change.Message)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("unknown format `%s`", format)
+}
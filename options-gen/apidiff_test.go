@@ -0,0 +1,85 @@
+package optionsgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIDiff(t *testing.T) {
+	t.Parallel()
+
+	write := func(source string) string {
+		dir := t.TempDir()
+		filename := filepath.Join(dir, "options.go")
+		require.NoError(t, os.WriteFile(filename, []byte(source), 0o600))
+
+		return filename
+	}
+
+	base := write("package testcase\n\ntype Options struct {\n" +
+		"\taddr    string `option:\"mandatory\"`\n" +
+		"\ttimeout int    `default:\"1\"`\n" +
+		"\tdebug   bool\n" +
+		"}\n")
+	current := write("package testcase\n\ntype Options struct {\n" +
+		"\taddr    string `option:\"mandatory\"`\n" +
+		"\tport    int    `option:\"mandatory\"`\n" +
+		"\ttimeout int    `default:\"2\"`\n" +
+		"\tname    string\n" +
+		"}\n")
+
+	changes, err := optionsgen.APIDiff(optionsgen.NewOptions(
+		optionsgen.WithInFilename(current),
+		optionsgen.WithStructName("Options"),
+		optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+	), base)
+	require.NoError(t, err)
+	assert.True(t, optionsgen.HasBreakingChanges(changes))
+
+	text, err := optionsgen.FormatAPIChanges(changes, optionsgen.APIDiffFormatText)
+	require.NoError(t, err)
+	assert.Equal(t, "breaking: new mandatory parameter `port` of the constructor\n"+
+		"behavioural: default of `timeout` changed from \"1\" to \"2\"\n"+
+		"compatible: added optional field `name` with setter WithName\n"+
+		"breaking: removed field `debug` with setter WithDebug\n", string(text))
+
+	jsonText, err := optionsgen.FormatAPIChanges(changes[2:3], optionsgen.APIDiffFormatJSON)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"kind":"compatible","field":"name","message":"added optional field `+
+		"`name`"+` with setter WithName"}]`, string(jsonText))
+
+	t.Run("no_changes", func(t *testing.T) {
+		t.Parallel()
+
+		changes, err := optionsgen.APIDiff(optionsgen.NewOptions(
+			optionsgen.WithInFilename(base),
+			optionsgen.WithStructName("Options"),
+			optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+		), base)
+		require.NoError(t, err)
+		assert.False(t, optionsgen.HasBreakingChanges(changes))
+
+		text, err := optionsgen.FormatAPIChanges(changes, optionsgen.APIDiffFormatText)
+		require.NoError(t, err)
+		assert.Equal(t, "no changes\n", string(text))
+
+		jsonText, err := optionsgen.FormatAPIChanges(changes, optionsgen.APIDiffFormatJSON)
+		require.NoError(t, err)
+		assert.Equal(t, "[]\n", string(jsonText))
+	})
+
+	t.Run("base_not_found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := optionsgen.APIDiff(optionsgen.NewOptions(
+			optionsgen.WithInFilename(current),
+			optionsgen.WithStructName("Options"),
+		), filepath.Join(t.TempDir(), "options.go"))
+		require.ErrorContains(t, err, "base:")
+	})
+}
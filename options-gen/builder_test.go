@@ -0,0 +1,44 @@
+package optionsgen_test
+
+import (
+	"testing"
+	"time"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-36-builder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsBuilder(t *testing.T) {
+	t.Run("build", func(t *testing.T) {
+		opts, err := testcase.NewOptionsBuilder[int](":8080").
+			WithLabels("a").
+			WithLabels("b").
+			WithValue(42).
+			WithTLS("cert.pem", "key.pem").
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, opts.IsSet(testcase.Fieldlabels))
+		assert.True(t, opts.IsSet(testcase.FieldcertFile))
+		// NOTE: defaults are marked as set, like in the functional options.
+		assert.True(t, opts.IsSet(testcase.Fieldtimeout))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := testcase.NewOptionsBuilder[int](":8080").WithDeadline(time.Millisecond).Build()
+		require.ErrorContains(t, err, "timeout")
+
+		assert.Panics(t, func() {
+			testcase.NewOptionsBuilder[int]("").MustBuild()
+		})
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		opts := testcase.NewOptionsBuilder[int](":8080").
+			Apply(func(o *testcase.Options[int]) {}).
+			MustBuild()
+
+		assert.False(t, opts.IsSet(testcase.Fieldvalue))
+	})
+}
@@ -1,9 +1,13 @@
 package optionsgen
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/kazhuravlev/options-gen/internal/ctype"
 	"github.com/kazhuravlev/options-gen/internal/generator"
@@ -16,11 +20,12 @@ const (
 	DefaultsFromNone DefaultsFrom = "none"
 	DefaultsFromVar  DefaultsFrom = "var"
 	DefaultsFromFunc DefaultsFrom = "func"
+	DefaultsFromFile DefaultsFrom = "file"
 )
 
 type Defaults struct {
 	From DefaultsFrom `json:"from"`
-	// Param is function name/variable name for func and var accordingly
+	// Param is function name/variable name/file name for func, var and file accordingly
 	Param string `json:"param"`
 }
 
@@ -32,34 +37,90 @@ const (
 	ConstructorNoRender      ConstructorTypeRender = "no"
 )
 
-var outOptionTypeNamePattern = regexp.MustCompile(`^[a-zA-Z]+$`)
+type DocFormat string
+
+const (
+	DocFormatMarkdown DocFormat = "markdown"
+	DocFormatHTML     DocFormat = "html"
+)
+
+type Style string
+
+const (
+	StyleFunctional Style = "functional"
+	StyleBuilder    Style = "builder"
+)
+
+type Naming string
+
+const (
+	NamingTitle Naming = "title"
+	NamingGo    Naming = "go"
+)
+
+var (
+	outOptionTypeNamePattern = regexp.MustCompile(`^[a-zA-Z]+$`)
+	setterPrefixPattern      = regexp.MustCompile(`^([A-Z][a-zA-Z0-9]*)?$`)
+	constructorNamePattern   = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9]*)?$`)
+)
 
 const defaultTagName = "default"
 
-func Run(opts Options) error {
+// Output is a file rendered by Generate.
+type Output struct {
+	Filename string
+	Content  []byte
+}
+
+// Generate renders all files configured by opts without writing them. The
+// warnings are returned as is, regardless of showWarnings.
+func Generate(opts Options) ([]Output, []string, error) {
+	if opts.fromFunc != "" && opts.structName == "" {
+		opts.structName = opts.fromFunc + "Params"
+	}
+
+	if opts.optionSpec != nil && opts.structName == "" {
+		opts.structName = opts.optionSpec.StructName
+	}
+
 	if err := opts.Validate(); err != nil {
-		return fmt.Errorf("bad configuration: %w", err)
+		return nil, nil, fmt.Errorf("bad configuration: %w", err)
 	}
 
 	tagName, varName, funcName := resolveDefaults(opts.defaults, opts.structName)
 
-	spec, err := generator.GetOptionSpec(
-		opts.inFilename,
-		opts.structName,
-		tagName,
-		opts.allVariadic,
-		opts.exclude,
-	)
+	spec, declareStruct, err := getOptionSpec(opts, tagName)
 	if err != nil {
-		return fmt.Errorf("cannot get options spec: %w", err)
+		return nil, nil, fmt.Errorf("cannot get options spec: %w", err)
 	}
 
 	outOptionTypeName, err := resolveOutOptionTypeName(opts.structName, opts.outOptionTypeName)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	if !setterPrefixPattern.MatchString(opts.setterPrefix) {
+		return nil, nil, fmt.Errorf("setterPrefix must be empty or an exported identifier")
+	}
+
+	if !constructorNamePattern.MatchString(opts.constructorName) {
+		return nil, nil, fmt.Errorf("constructorName must be a valid function name, contains only letters and digits")
+	}
+
+	defaultsFile, err := resolveDefaultsFile(opts.defaults, spec.Spec.Options)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	res, err := generator.Render(generator.NewOptions(
+	var presets []generator.Preset
+	if opts.withCombinators {
+		presets, err = generator.GetPresets(opts.inFilename, opts.structName, outOptionTypeName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot get presets: %w", err)
+		}
+	}
+
+	genOpts := generator.NewOptions(
 		generator.WithVersion(opts.version),
 		generator.WithPackageName(opts.packageName),
 		generator.WithOptionsStructName(opts.structName),
@@ -68,21 +129,91 @@ func Run(opts Options) error {
 		generator.WithTagName(tagName),
 		generator.WithVarName(varName),
 		generator.WithFuncName(funcName),
+		generator.WithDefaultsFile(defaultsFile),
 		generator.WithPrefix(opts.outPrefix),
 		generator.WithWithIsset(opts.withIsset),
+		generator.WithEnvPrefix(opts.envPrefix),
+		generator.WithWithFlags(opts.withFlags),
+		generator.WithWithDecode(opts.withDecode),
+		generator.WithDecodeStrict(opts.decodeStrict),
+		generator.WithWithStringer(opts.withStringer),
+		generator.WithWithSpec(opts.withSpec),
+		generator.WithWithSource(opts.withSource),
 		generator.WithConstructorTypeRender(string(opts.constructorTypeRender)),
 		generator.WithOptionTypeName(outOptionTypeName),
-	))
+		generator.WithNaming(generator.Naming(opts.naming)),
+		generator.WithInitialisms(opts.initialisms),
+		generator.WithSetterPrefix(opts.setterPrefix),
+		generator.WithConstructorName(opts.constructorName),
+		generator.WithWithCombinators(opts.withCombinators),
+		generator.WithPresets(presets),
+		generator.WithStyle(generator.Style(opts.style)),
+		declareStruct,
+	)
+
+	res, err := generator.Render(genOpts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot renderOptions template: %w", err)
+	}
+
+	outputs := []Output{{Filename: opts.outFilename, Content: res}}
+
+	if opts.docOut != "" {
+		doc, err := renderDoc(opts, genOpts, varName, funcName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outputs = append(outputs, Output{Filename: opts.docOut, Content: doc})
+	}
+
+	if opts.withTests {
+		tests, err := generator.RenderTests(genOpts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot render tests: %w", err)
+		}
+
+		testsFilename := strings.TrimSuffix(opts.outFilename, ".go") + "_test.go"
+		outputs = append(outputs, Output{Filename: testsFilename, Content: tests})
+	}
+
+	warnings := spec.Warnings
+
+	if opts.jsonSchemaOut != "" {
+		schema, schemaWarnings, err := generator.RenderJSONSchema(genOpts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot render JSON Schema: %w", err)
+		}
+
+		outputs = append(outputs, Output{Filename: opts.jsonSchemaOut, Content: schema})
+		warnings = append(warnings, schemaWarnings...)
+	}
+
+	return outputs, warnings, nil
+}
+
+func Run(opts Options) error {
+	outputs, warnings, err := Generate(opts)
 	if err != nil {
-		return fmt.Errorf("cannot renderOptions template: %w", err)
+		return err
 	}
 
-	if err := os.WriteFile(opts.outFilename, res, ctype.DefaultPermission); err != nil {
-		return fmt.Errorf("cannot write result: %w", err)
+	for _, out := range outputs {
+		if opts.check {
+			if err := checkOutput(out); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := os.WriteFile(out.Filename, out.Content, ctype.DefaultPermission); err != nil {
+			return fmt.Errorf("cannot write result: %w", err)
+		}
 	}
 
 	if opts.showWarnings {
-		for _, warning := range spec.Warnings {
+		for _, warning := range warnings {
 			opts.warningsHandler(warning)
 		}
 	}
@@ -90,6 +221,42 @@ func Run(opts Options) error {
 	return nil
 }
 
+func checkOutput(out Output) error {
+	current, err := os.ReadFile(out.Filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot read result: %w", err)
+	}
+
+	if !bytes.Equal(current, out.Content) {
+		return fmt.Errorf("file %s is out of date", out.Filename)
+	}
+
+	return nil
+}
+
+func renderDoc(opts Options, genOpts generator.Options, varName, funcName string) ([]byte, error) {
+	// NOTE: defaults from var and func are known only as the source code.
+	var defaults map[string]string
+	if varName != "" || funcName != "" {
+		var err error
+		defaults, err = generator.GetDefaultsFromSource(opts.inFilename, varName, funcName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get defaults for doc: %w", err)
+		}
+
+		if defaults == nil {
+			defaults = make(map[string]string)
+		}
+	}
+
+	doc, err := generator.RenderDoc(generator.DocFormat(opts.docFormat), genOpts, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render doc: %w", err)
+	}
+
+	return doc, nil
+}
+
 func resolveDefaults(defaults Defaults, structName string) (tagName, varName, funcName string) {
 	switch defaults.From {
 	case DefaultsFromNone:
@@ -113,6 +280,91 @@ func resolveDefaults(defaults Defaults, structName string) (tagName, varName, fu
 	return tagName, varName, funcName
 }
 
+func resolveDefaultsFile(defaults Defaults, options []generator.OptionMeta) (*generator.DefaultsFile, error) {
+	if defaults.From != DefaultsFromFile {
+		return nil, nil //nolint:nilnil
+	}
+
+	if defaults.Param == "" {
+		return nil, fmt.Errorf("defaults file is not specified")
+	}
+
+	defaultsFile, err := generator.LoadDefaultsFile(defaults.Param)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load defaults: %w", err)
+	}
+
+	if err := defaultsFile.Apply(options); err != nil {
+		return nil, fmt.Errorf("cannot apply defaults: %w", err)
+	}
+
+	return defaultsFile, nil
+}
+
+// getOptionSpec reads options of the struct, of the function parameters for
+// fromFunc or of optionSpec. The returned setter makes the generator declare
+// the struct, when it is not declared in the source.
+func getOptionSpec(opts Options, tagName string) (*generator.GetOptionSpecRes, generator.OptOptionsSetter, error) {
+	if opts.optionSpec != nil {
+		return getSchemaOptionSpec(opts, tagName)
+	}
+
+	if opts.fromFunc == "" {
+		spec, err := generator.GetOptionSpec(opts.inFilename, opts.structName, tagName, opts.allVariadic, opts.exclude)
+
+		return spec, generator.WithStructDecl(nil), err //nolint:wrapcheck
+	}
+
+	if opts.style != StyleFunctional {
+		return nil, nil, fmt.Errorf("options from a function support only the %s style", StyleFunctional)
+	}
+
+	if opts.constructorTypeRender == ConstructorNoRender {
+		return nil, nil, fmt.Errorf("options from a function require a constructor")
+	}
+
+	spec, funcSpec, err := generator.GetFuncOptionSpec(
+		opts.inFilename, opts.fromFunc, tagName, opts.allVariadic, opts.exclude)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	return spec, generator.WithFuncSpec(funcSpec), nil
+}
+
+func getSchemaOptionSpec(opts Options, tagName string) (*generator.GetOptionSpecRes, generator.OptOptionsSetter, error) {
+	switch {
+	case opts.fromFunc != "":
+		return nil, nil, fmt.Errorf("options from a spec cannot be read from a function")
+	case opts.structName != opts.optionSpec.StructName:
+		return nil, nil, fmt.Errorf("struct name `%s` differs from the struct `%s` of the spec",
+			opts.structName, opts.optionSpec.StructName)
+	case opts.defaults.From == DefaultsFromVar || opts.defaults.From == DefaultsFromFunc:
+		return nil, nil, fmt.Errorf("defaults of a spec cannot be read from %s", opts.defaults.From)
+	case opts.withCombinators:
+		return nil, nil, fmt.Errorf("options from a spec do not support combinators")
+	}
+
+	for _, opt := range opts.optionSpec.Options {
+		if tagName == "" && opt.TagOption.Default != "" {
+			return nil, nil, fmt.Errorf("field `%s`: default is set, but defaults are not read from the tag", opt.Field)
+		}
+	}
+
+	src, err := opts.optionSpec.Source(opts.packageName, tagName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	spec, decl, err := generator.GetSourceOptionSpec(src, opts.structName,
+		filepath.Dir(opts.outFilename), tagName, opts.allVariadic, opts.exclude)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	return spec, generator.WithStructDecl(decl), nil
+}
+
 func resolveOutOptionTypeName(structName, outOptionTypeName string) (string, error) {
 	if outOptionTypeName == "" {
 		return "Opt" + structName + "Setter", nil
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,11 +43,25 @@ func TestRun(t *testing.T) {
 				paramsFilename := filepath.Join(dir, ".params.json")
 				params := readParams(paramsFilename)
 
+				structName := "Options"
+				if params.FromFunc != "" {
+					structName = ""
+				}
+
+				var docFilename, schemaFilename string
+				if params.DocOut != "" {
+					docFilename = filepath.Join(dir, params.DocOut)
+				}
+
+				if params.JSONSchemaOut != "" {
+					schemaFilename = filepath.Join(dir, params.JSONSchemaOut)
+				}
+
 				err := optionsgen.Run(optionsgen.NewOptions(
 					optionsgen.WithVersion("qa-version"),
 					optionsgen.WithInFilename(filepath.Join(dir, "options.go")),
 					optionsgen.WithOutFilename(outFilename),
-					optionsgen.WithStructName("Options"),
+					optionsgen.WithStructName(structName),
 					optionsgen.WithPackageName("testcase"),
 					optionsgen.WithOutPrefix(params.OutPrefix),
 					optionsgen.WithDefaults(params.Defaults),
@@ -55,14 +70,73 @@ func TestRun(t *testing.T) {
 					optionsgen.WithAllVariadic(params.AllVariadic),
 					optionsgen.WithConstructorTypeRender(params.Constructor),
 					optionsgen.WithOutOptionTypeName(params.OptionTypeName),
+					optionsgen.WithEnvPrefix(params.EnvPrefix),
+					optionsgen.WithWithFlags(params.WithFlags),
+					optionsgen.WithWithDecode(params.WithDecode),
+					optionsgen.WithDecodeStrict(params.DecodeStrict),
+					optionsgen.WithWithStringer(params.WithStringer),
+					optionsgen.WithWithSpec(params.WithSpec),
+					optionsgen.WithWithSource(params.WithSource),
+					optionsgen.WithDocOut(docFilename),
+					optionsgen.WithDocFormat(params.DocFormat),
+					optionsgen.WithJSONSchemaOut(schemaFilename),
+					optionsgen.WithNaming(params.Naming),
+					optionsgen.WithInitialisms(params.Initialisms...),
+					optionsgen.WithSetterPrefix(params.SetterPrefix),
+					optionsgen.WithConstructorName(params.ConstructorName),
+					optionsgen.WithWithCombinators(params.WithCombinators),
+					optionsgen.WithStyle(params.Style),
+					optionsgen.WithWithTests(params.WithTests),
+					optionsgen.WithFromFunc(params.FromFunc),
 				))
 				assert.NoError(t, err)
 
 				helpEqualFiles(t, expFilename, outFilename)
+				if docFilename != "" {
+					helpEqualFiles(t, docFilename+".expected", docFilename)
+				}
+
+				if schemaFilename != "" {
+					helpEqualFiles(t, schemaFilename+".expected", schemaFilename)
+				}
+
+				if params.WithTests {
+					testsFilename := filepath.Join(dir, "options_generated_test.go")
+					helpEqualFiles(t, testsFilename+".expected", testsFilename)
+				}
 			})
 		}
 	})
 
+	t.Run("check", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		source, err := os.ReadFile(filepath.Join("testdata", "case-29-doc-markdown", "options.go"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "options.go"), source, 0o600))
+
+		run := func(check bool) error {
+			return optionsgen.Run(optionsgen.NewOptions(
+				optionsgen.WithVersion("qa-version"),
+				optionsgen.WithInFilename(filepath.Join(dir, "options.go")),
+				optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+				optionsgen.WithStructName("Options"),
+				optionsgen.WithPackageName("testcase"),
+				optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+				optionsgen.WithDocOut(filepath.Join(dir, "OPTIONS.md")),
+				optionsgen.WithCheck(check),
+			))
+		}
+
+		require.ErrorContains(t, run(true), "options_generated.go is out of date")
+		require.NoError(t, run(false))
+		require.NoError(t, run(true))
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "OPTIONS.md"), []byte("outdated"), 0o600))
+		require.ErrorContains(t, run(true), "OPTIONS.md is out of date")
+	})
+
 	t.Run("source_not_found", func(t *testing.T) {
 		t.Parallel()
 
@@ -86,12 +160,30 @@ func TestRun(t *testing.T) {
 }
 
 type Params struct {
-	OutPrefix      string                           `json:"out_prefix"` //nolint:tagliatelle
-	Defaults       optionsgen.Defaults              `json:"defaults"`
-	Constructor    optionsgen.ConstructorTypeRender `json:"constructor"`
-	WithIsset      bool                             `json:"with_isset"`       //nolint:tagliatelle
-	AllVariadic    bool                             `json:"all_variadic"`     //nolint:tagliatelle
-	OptionTypeName string                           `json:"option_type_name"` //nolint:tagliatelle
+	OutPrefix       string                           `json:"out_prefix"` //nolint:tagliatelle
+	Defaults        optionsgen.Defaults              `json:"defaults"`
+	Constructor     optionsgen.ConstructorTypeRender `json:"constructor"`
+	WithIsset       bool                             `json:"with_isset"`       //nolint:tagliatelle
+	AllVariadic     bool                             `json:"all_variadic"`     //nolint:tagliatelle
+	OptionTypeName  string                           `json:"option_type_name"` //nolint:tagliatelle
+	EnvPrefix       string                           `json:"env_prefix"`       //nolint:tagliatelle
+	WithFlags       bool                             `json:"with_flags"`       //nolint:tagliatelle
+	WithDecode      bool                             `json:"with_decode"`      //nolint:tagliatelle
+	DecodeStrict    bool                             `json:"decode_strict"`    //nolint:tagliatelle
+	WithStringer    bool                             `json:"with_stringer"`    //nolint:tagliatelle
+	WithSpec        bool                             `json:"with_spec"`        //nolint:tagliatelle
+	WithSource      bool                             `json:"with_source"`      //nolint:tagliatelle
+	DocOut          string                           `json:"doc_out"`          //nolint:tagliatelle
+	DocFormat       optionsgen.DocFormat             `json:"doc_format"`       //nolint:tagliatelle
+	JSONSchemaOut   string                           `json:"json_schema_out"`  //nolint:tagliatelle
+	Naming          optionsgen.Naming                `json:"naming"`
+	Initialisms     []string                         `json:"initialisms"`
+	SetterPrefix    string                           `json:"setter_prefix"`    //nolint:tagliatelle
+	ConstructorName string                           `json:"constructor_name"` //nolint:tagliatelle
+	WithCombinators bool                             `json:"with_combinators"` //nolint:tagliatelle
+	Style           optionsgen.Style                 `json:"style"`
+	WithTests       bool                             `json:"with_tests"` //nolint:tagliatelle
+	FromFunc        string                           `json:"from_func"`  //nolint:tagliatelle
 }
 
 func readParams(filename string) Params {
@@ -101,10 +193,28 @@ func readParams(filename string) Params {
 			From:  optionsgen.DefaultsFromTag,
 			Param: "",
 		},
-		Constructor:    optionsgen.ConstructorPublicRender,
-		WithIsset:      false,
-		AllVariadic:    false,
-		OptionTypeName: "",
+		Constructor:     optionsgen.ConstructorPublicRender,
+		WithIsset:       false,
+		AllVariadic:     false,
+		OptionTypeName:  "",
+		EnvPrefix:       "",
+		WithFlags:       false,
+		WithDecode:      false,
+		DecodeStrict:    false,
+		WithStringer:    false,
+		WithSpec:        false,
+		WithSource:      false,
+		DocOut:          "",
+		DocFormat:       optionsgen.DocFormatMarkdown,
+		JSONSchemaOut:   "",
+		Naming:          optionsgen.NamingTitle,
+		Initialisms:     nil,
+		SetterPrefix:    "With",
+		ConstructorName: "",
+		WithCombinators: false,
+		Style:           optionsgen.StyleFunctional,
+		WithTests:       false,
+		FromFunc:        "",
 	}
 
 	bb, err := os.ReadFile(filename)
@@ -130,3 +240,46 @@ func helpEqualFiles(t *testing.T, filename1, filename2 string) {
 
 	assert.Equal(t, string(f1Bytes), string(f2Bytes))
 }
+
+func TestOptionsSourceField(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	generate := func(opts ...optionsgen.OptOptionsSetter) ([]optionsgen.Output, error) {
+		outputs, _, err := optionsgen.Generate(optionsgen.NewOptions(append([]optionsgen.OptOptionsSetter{
+			optionsgen.WithVersion("qa-version"),
+			optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+			optionsgen.WithStructName("Options"),
+			optionsgen.WithPackageName("testcase"),
+			optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: "default"}),
+			optionsgen.WithWithSource(true),
+		}, opts...)...))
+
+		return outputs, err
+	}
+
+	t.Run("missing_field", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(dir, "options.go")
+		require.NoError(t, os.WriteFile(filename, []byte("package testcase\n\ntype Options struct {\n\taddr string\n}\n"), 0o600))
+
+		_, err := generate(optionsgen.WithInFilename(filename))
+		require.ErrorContains(t, err, "struct `Options` should have a field of type optionsinfo.Sources")
+	})
+
+	t.Run("declared_struct", func(t *testing.T) {
+		t.Parallel()
+
+		outputs, err := generate(
+			optionsgen.WithInFilename(filepath.Join(dir, "options.yaml")),
+			optionsgen.WithOptionSpec(optionspec.New("Options").Add(optionspec.Option("addr", "string"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+
+		content := string(outputs[0].Content)
+		assert.Contains(t, content, "\toptSources optionsinfo461e464ebed9.Sources\n")
+		assert.Contains(t, content, "o.optSources.Set(int(Fieldaddr), optionsinfo461e464ebed9.SourceSetter)")
+	})
+}
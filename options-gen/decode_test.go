@@ -0,0 +1,67 @@
+package optionsgen_test
+
+import (
+	"testing"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-25-decode"
+	"github.com/kazhuravlev/options-gen/pkg/decoder"
+	"github.com/kazhuravlev/options-gen/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsFromMap(t *testing.T) {
+	t.Run("decoded options are set", func(t *testing.T) {
+		setters, err := testcase.OptionsFromMap(map[string]any{
+			"max_retries": 3,
+			"listen":      ":8080",
+			"labels":      []any{"a", "b"},
+			"headers":     map[string]any{"X-Id": "1"},
+		})
+		require.NoError(t, err)
+		require.Len(t, setters, 4)
+
+		opts := testcase.NewOptions(nil, setters...)
+		assert.True(t, opts.IsSet(testcase.FieldlistenAddr))
+		assert.True(t, opts.IsSet(testcase.Fieldlabels))
+		assert.NoError(t, opts.Validate())
+	})
+
+	t.Run("strict mode rejects unknown and mandatory keys", func(t *testing.T) {
+		_, err := testcase.OptionsFromMap(map[string]any{
+			"http_client": nil,
+			"listen_addr": ":8080",
+		})
+		require.Error(t, err)
+
+		var parseErrs errors.ParseErrors
+		require.ErrorAs(t, err, &parseErrs)
+		errs := parseErrs.Errors()
+		require.Len(t, errs, 2)
+		assert.Equal(t, "http_client", errs[0].Name)
+		assert.ErrorIs(t, &errs[0], decoder.ErrUnknownKey)
+		assert.Equal(t, "listen_addr", errs[1].Name)
+	})
+
+	t.Run("bad values", func(t *testing.T) {
+		_, err := testcase.OptionsFromMap(map[string]any{
+			"timeout":     "soon",
+			"max_retries": 1000,
+		})
+
+		var parseErrs errors.ParseErrors
+		require.ErrorAs(t, err, &parseErrs)
+		require.Len(t, parseErrs.Errors(), 2)
+	})
+}
+
+func TestOptionsFromJSON(t *testing.T) {
+	setters, err := testcase.OptionsFromJSON([]byte(`{"timeout": "5s", "max_retries": 2, "headers": {"a": "b"}}`))
+	require.NoError(t, err)
+
+	opts := testcase.NewOptions(nil, setters...)
+	assert.NoError(t, opts.Validate())
+
+	_, err = testcase.OptionsFromJSON([]byte(`{`))
+	require.Error(t, err)
+}
@@ -1,10 +1,12 @@
 package optionsgen_test
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
 	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-12-defaults-tag-02"
+	quotedcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-40-quoted-defaults"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -58,3 +60,13 @@ func TestDefaultValues(t *testing.T) {
 		})
 	}
 }
+
+// TestQuotedDefaultValues checks that string defaults are Go string literals:
+// quotes and backslashes of the tag value are kept as is.
+func TestQuotedDefaultValues(t *testing.T) {
+	opts := reflect.ValueOf(quotedcase.NewOptions())
+
+	assert.Equal(t, `say "hi"`, opts.FieldByName("greeting").String())
+	assert.Equal(t, `C:\temp`, opts.FieldByName("path").String())
+	assert.Equal(t, `\d+`, opts.FieldByName("pattern").String())
+}
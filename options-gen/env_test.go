@@ -0,0 +1,76 @@
+package optionsgen_test
+
+import (
+	"strconv"
+	"testing"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-23-env"
+	"github.com/kazhuravlev/options-gen/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOptionsFromEnv(t *testing.T) {
+	lookup := func(env map[string]string) func(string) (string, bool) {
+		return func(key string) (string, bool) {
+			val, ok := env[key]
+
+			return val, ok
+		}
+	}
+
+	t.Run("env overrides defaults", func(t *testing.T) {
+		envOpts, err := testcase.LoadOptionsFromEnv(lookup(map[string]string{
+			"APP_RETRIES": "0",
+			"APP_NAME":    "joe",
+		}))
+		require.NoError(t, err)
+		require.Len(t, envOpts, 2)
+
+		opts := testcase.NewOptions(nil, envOpts...)
+		assert.True(t, opts.IsSet(testcase.Fieldname))
+		assert.False(t, opts.IsSet(testcase.Fielddebug))
+		assert.Error(t, opts.Validate())
+	})
+
+	t.Run("explicit setters override env", func(t *testing.T) {
+		envOpts, err := testcase.LoadOptionsFromEnv(lookup(map[string]string{
+			"APP_RETRIES": "0",
+		}))
+		require.NoError(t, err)
+
+		opts := testcase.NewOptions(nil, append(envOpts, testcase.WithRetries(3))...)
+		assert.NoError(t, opts.Validate())
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		_, err := testcase.LoadOptionsFromEnv(lookup(map[string]string{
+			"APP_RETRIES": "1000",
+			"APP_TIMEOUT": "soon",
+			"APP_DEBUG":   "true",
+		}))
+		require.Error(t, err)
+
+		var parseErrs errors.ParseErrors
+		require.ErrorAs(t, err, &parseErrs)
+		require.Len(t, parseErrs.Errors(), 2)
+		assert.Equal(t, "APP_TIMEOUT", parseErrs.Errors()[0].Name)
+		assert.Equal(t, "APP_RETRIES", parseErrs.Errors()[1].Name)
+		assert.Equal(t, "1000", parseErrs.Errors()[1].Value)
+	})
+
+	t.Run("secret parse errors", func(t *testing.T) {
+		_, err := testcase.LoadOptionsFromEnv(lookup(map[string]string{
+			"APP_PIN": "12ab-secret",
+		}))
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "12ab-secret")
+		assert.Contains(t, err.Error(), "(APP_PIN): cannot parse")
+
+		var parseErrs errors.ParseErrors
+		require.ErrorAs(t, err, &parseErrs)
+		require.Len(t, parseErrs.Errors(), 1)
+		assert.Equal(t, "***", parseErrs.Errors()[0].Value)
+		assert.ErrorIs(t, &parseErrs.Errors()[0], strconv.ErrSyntax)
+	})
+}
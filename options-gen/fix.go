@@ -0,0 +1,37 @@
+package optionsgen
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kazhuravlev/options-gen/internal/ctype"
+	"github.com/kazhuravlev/options-gen/internal/generator"
+)
+
+type FixResult struct {
+	// Files are the rewritten files.
+	Files    []string
+	Warnings []string
+}
+
+// Fix migrates the options struct in place: the deprecated `required` option
+// becomes `mandatory`, and `not-empty` becomes the `required` validation
+// rule. With unexportFields public fields are unexported, and references to
+// them are updated within the package.
+func Fix(inFilename, structName string, unexportFields bool) (FixResult, error) {
+	files, warnings, err := generator.Fix(inFilename, structName, unexportFields)
+	if err != nil {
+		return FixResult{}, fmt.Errorf("cannot fix options: %w", err)
+	}
+
+	res := FixResult{Files: make([]string, 0, len(files)), Warnings: warnings}
+	for _, file := range files {
+		if err := os.WriteFile(file.Filename, file.Content, ctype.DefaultPermission); err != nil {
+			return res, fmt.Errorf("cannot write result: %w", err)
+		}
+
+		res.Files = append(res.Files, file.Filename)
+	}
+
+	return res, nil
+}
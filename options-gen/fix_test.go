@@ -0,0 +1,125 @@
+package optionsgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFix(t *testing.T) {
+	t.Parallel()
+
+	const options = `package testcase
+
+// Options of the client.
+type Options struct {
+	// Addr is an address of the server.
+	Addr    string ` + "`option:\"required,not-empty\"`" + `
+	Timeout int    ` + "`default:\"1\"`" + ` // in seconds
+	debug   bool
+}
+`
+
+	const usage = `package testcase
+
+func (o *Options) Describe() string {
+	return o.Addr
+}
+`
+
+	const usageTest = `package testcase
+
+import "testing"
+
+func TestOptions(t *testing.T) {
+	opts := Options{Addr: "localhost", Timeout: 1}
+	_ = opts.Timeout
+}
+`
+
+	write := func(dir, name, content string) string {
+		filename := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+
+		return filename
+	}
+
+	read := func(filename string) string {
+		content, err := os.ReadFile(filename)
+		require.NoError(t, err)
+
+		return string(content)
+	}
+
+	t.Run("tags", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		filename := write(dir, "options.go", options)
+
+		res, err := optionsgen.Fix(filename, "Options", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filename}, res.Files)
+		assert.Empty(t, res.Warnings)
+		assert.Equal(t, `package testcase
+
+// Options of the client.
+type Options struct {
+	// Addr is an address of the server.
+	Addr    string `+"`option:\"mandatory\" validate:\"required\"`"+`
+	Timeout int    `+"`default:\"1\"`"+` // in seconds
+	debug   bool
+}
+`, read(filename))
+
+		res, err = optionsgen.Fix(filename, "Options", false)
+		require.NoError(t, err)
+		assert.Empty(t, res.Files)
+	})
+
+	t.Run("unexport", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		write(dir, "go.mod", "module example.com/testcase\n\ngo 1.22\n")
+		filename := write(dir, "options.go", options)
+		usageFilename := write(dir, "usage.go", usage)
+		testFilename := write(dir, "usage_test.go", usageTest)
+
+		res, err := optionsgen.Fix(filename, "Options", true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filename, usageFilename, testFilename}, res.Files)
+		assert.Empty(t, res.Warnings)
+		assert.Equal(t, `package testcase
+
+// Options of the client.
+type Options struct {
+	// Addr is an address of the server.
+	addr    string `+"`option:\"mandatory\" validate:\"required\"`"+`
+	timeout int    `+"`default:\"1\"`"+` // in seconds
+	debug   bool
+}
+`, read(filename))
+		assert.Contains(t, read(usageFilename), "return o.addr\n")
+		assert.Contains(t, read(testFilename), "opts := Options{addr: \"localhost\", timeout: 1}\n\t_ = opts.timeout\n")
+	})
+
+	t.Run("method_conflict", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		write(dir, "go.mod", "module example.com/testcase\n\ngo 1.22\n")
+		filename := write(dir, "options.go", options)
+		write(dir, "usage.go", usage+"\nfunc (o *Options) addr() string { return o.Addr }\n")
+
+		res, err := optionsgen.Fix(filename, "Options", true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Warning: cannot unexport `Addr`: `addr` is already used"}, res.Warnings)
+		assert.Contains(t, read(filename), "\ttimeout int")
+		assert.Contains(t, read(filename), "\tAddr    string")
+	})
+}
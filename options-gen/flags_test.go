@@ -0,0 +1,63 @@
+package optionsgen_test
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-24-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterOptionsFlags(t *testing.T) {
+	newFlagSet := func() *flag.FlagSet {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+
+		return fs
+	}
+
+	t.Run("only provided flags are applied", func(t *testing.T) {
+		fs := newFlagSet()
+		getOpts := testcase.RegisterOptionsFlags(fs, "client.")
+		require.NoError(t, fs.Parse([]string{"-client.max-retries=2", "-client.debug"}))
+
+		opts := testcase.NewOptions(nil, getOpts())
+		assert.NoError(t, opts.Validate())
+	})
+
+	t.Run("explicit setters have a precedence", func(t *testing.T) {
+		fs := newFlagSet()
+		getOpts := testcase.RegisterOptionsFlags(fs, "")
+		require.NoError(t, fs.Parse([]string{"-max-retries=2"}))
+
+		opts := testcase.NewOptions(nil, getOpts(), testcase.WithMaxRetries(0))
+		assert.Error(t, opts.Validate())
+	})
+
+	t.Run("usage and defaults", func(t *testing.T) {
+		fs := newFlagSet()
+		testcase.RegisterOptionsFlags(fs, "")
+
+		timeoutFlag := fs.Lookup("timeout")
+		require.NotNil(t, timeoutFlag)
+		assert.Equal(t, "Timeout for each request.", timeoutFlag.Usage)
+		assert.Equal(t, "3s", timeoutFlag.DefValue)
+		assert.Empty(t, fs.Lookup("max-retries").DefValue)
+
+		var usage strings.Builder
+		fs.SetOutput(&usage)
+		fs.PrintDefaults()
+		assert.Contains(t, usage.String(), "Timeout for each request. (default 3s)")
+		assert.Nil(t, fs.Lookup("http-client"))
+		assert.Nil(t, fs.Lookup("tags"))
+	})
+
+	t.Run("bad value", func(t *testing.T) {
+		fs := newFlagSet()
+		testcase.RegisterOptionsFlags(fs, "")
+		require.Error(t, fs.Parse([]string{"-max-retries=1000"}))
+	})
+}
@@ -0,0 +1,40 @@
+package optionsgen_test
+
+import (
+	"testing"
+	"time"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-34-group"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsGroup(t *testing.T) {
+	opts := testcase.NewOptions(":8080",
+		testcase.WithTLS("cert.pem", "key.pem"),
+		testcase.WithCredentials("user", "pass", []string{"read"}),
+		testcase.WithMaxBackoff(time.Minute),
+	)
+
+	values := make(map[string]any)
+	for field, value := range opts.SpecValues() {
+		values[field.Name] = value
+	}
+
+	assert.Equal(t, "cert.pem", values["certFile"])
+	assert.Equal(t, "key.pem", values["keyFile"])
+	assert.Equal(t, 100*time.Millisecond, values["minBackoff"])
+	assert.Equal(t, time.Minute, values["maxBackoff"])
+	assert.Equal(t, []string{"read"}, values["scopes"])
+
+	assert.True(t, opts.IsSet(testcase.FieldcertFile))
+	assert.True(t, opts.IsSet(testcase.FieldkeyFile))
+	assert.True(t, opts.IsSet(testcase.Fieldscopes))
+	assert.True(t, opts.IsSet(testcase.FieldmaxBackoff))
+}
+
+func TestOptionsGroup_NotSet(t *testing.T) {
+	opts := testcase.NewOptions(":8080")
+
+	assert.False(t, opts.IsSet(testcase.FieldcertFile))
+	assert.False(t, opts.IsSet(testcase.Fieldusername))
+}
@@ -57,6 +57,135 @@ type Options struct {
 			wantErr:   true,
 			errSubstr: "invalid",
 		},
+		{
+			name: "env tag on mandatory field",
+			sourceCode: `package test
+type Options struct {
+	field string ` + "`option:\"mandatory\" env:\"FIELD\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "mandatory option cannot be read from env",
+		},
+		{
+			name: "env tag on unsupported type",
+			sourceCode: `package test
+type Options struct {
+	field []string ` + "`env:\"FIELD\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "invalid `env` tag: unsupported type `[]string`",
+		},
+		{
+			name: "alias on mandatory field",
+			sourceCode: `package test
+type Options struct {
+	field string ` + "`option:\"mandatory,alias=Old\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "mandatory option cannot have an alias",
+		},
+		{
+			name: "invalid alias",
+			sourceCode: `package test
+type Options struct {
+	field string ` + "`option:\"alias=old\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "invalid alias `old`",
+		},
+		{
+			name: "group on mandatory field",
+			sourceCode: `package test
+type Options struct {
+	field string ` + "`option:\"mandatory,group=Pair\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "mandatory option cannot be in a group",
+		},
+		{
+			name: "standalone without group",
+			sourceCode: `package test
+type Options struct {
+	field string ` + "`option:\"standalone\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "standalone setter is allowed only for grouped options",
+		},
+		{
+			name: "alias conflicts with setter",
+			sourceCode: `package test
+type Options struct {
+	timeout int
+	requestTimeout int ` + "`option:\"alias=Timeout\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "field `requestTimeout` alias: setter `WithTimeout` conflicts with the setter of field `timeout`",
+		},
+		{
+			name: "group conflicts with setter",
+			sourceCode: `package test
+type Options struct {
+	pair int
+	host string ` + "`option:\"group=Pair\"`" + `
+	port int    ` + "`option:\"group=Pair\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "group `Pair` of field `host`: setter `WithPair` conflicts with the setter of field `pair`",
+		},
+		{
+			name: "alias conflicts with own setter",
+			sourceCode: `package test
+type Options struct {
+	timeout int ` + "`option:\"alias=Timeout\"`" + `
+}`,
+			opts: NewOptions(
+				WithVersion("test"),
+				WithPackageName("test"),
+				WithStructName("Options"),
+			),
+			wantErr:   true,
+			errSubstr: "field `timeout` alias: setter `WithTimeout` conflicts with the setter of field `timeout`",
+		},
 		{
 			name: "struct not found",
 			sourceCode: `package test
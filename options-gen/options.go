@@ -3,9 +3,11 @@ package optionsgen
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
 )
 
-//go:generate toolset run options-gen -from-struct=Options -all-variadic=true -defaults-from=var
+//go:generate go run ../cmd/options-gen -from-struct=Options -all-variadic=true -defaults-from=var -naming=go
 type Options struct {
 	version               string `validate:"required"`
 	inFilename            string `validate:"required"`
@@ -20,6 +22,26 @@ type Options struct {
 	constructorTypeRender ConstructorTypeRender `validate:"required,oneof=public private no"`
 	outOptionTypeName     string
 	exclude               []*regexp.Regexp
+	envPrefix             string
+	withFlags             bool
+	withDecode            bool
+	decodeStrict          bool
+	withStringer          bool
+	withSpec              bool
+	withSource            bool
+	docOut                string
+	docFormat             DocFormat `validate:"required,oneof=markdown html"`
+	check                 bool
+	jsonSchemaOut         string
+	naming                Naming `validate:"required,oneof=title go"`
+	initialisms           []string
+	setterPrefix          string
+	constructorName       string
+	withCombinators       bool
+	style                 Style `validate:"required,oneof=functional builder"`
+	withTests             bool
+	fromFunc              string
+	optionSpec            *optionspec.OptionSpec
 	warningsHandler       func(string)
 }
 
@@ -40,6 +62,26 @@ var defaultOptions = Options{
 	constructorTypeRender: ConstructorPublicRender,
 	outOptionTypeName:     "",
 	exclude:               nil,
+	envPrefix:             "",
+	withFlags:             false,
+	withDecode:            false,
+	decodeStrict:          false,
+	withStringer:          false,
+	withSpec:              false,
+	withSource:            false,
+	docOut:                "",
+	docFormat:             DocFormatMarkdown,
+	check:                 false,
+	jsonSchemaOut:         "",
+	naming:                NamingTitle,
+	initialisms:           nil,
+	setterPrefix:          "With",
+	constructorName:       "",
+	withCombinators:       false,
+	style:                 StyleFunctional,
+	withTests:             false,
+	fromFunc:              "",
+	optionSpec:            nil,
 	warningsHandler: func(msg string) {
 		fmt.Println(msg) //nolint:forbidigo
 	},
@@ -0,0 +1,59 @@
+package optionsgen_test
+
+import (
+	"testing"
+	"time"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-35-presets"
+	genericcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-39-generic-presets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsPresets(t *testing.T) {
+	values := func(opts *testcase.Options) map[string]any {
+		res := make(map[string]any)
+		for field, value := range opts.SpecValues() {
+			res[field.Name] = value
+		}
+
+		return res
+	}
+
+	t.Run("partial_options", func(t *testing.T) {
+		opts := testcase.NewOptions(":8080", testcase.ForTesting())
+
+		res := values(&opts)
+		assert.Equal(t, time.Second, res["timeout"])
+		assert.Equal(t, 3, res["retries"])
+		assert.Equal(t, true, res["debug"])
+	})
+
+	t.Run("setters", func(t *testing.T) {
+		opts := testcase.NewOptions(":8080", testcase.ProductionDefaults(), testcase.WithRetries(7))
+
+		res := values(&opts)
+		assert.Equal(t, 10*time.Second, res["timeout"])
+		assert.Equal(t, 7, res["retries"])
+		assert.Equal(t, false, res["debug"])
+	})
+
+	t.Run("combinators", func(t *testing.T) {
+		opts := testcase.NewOptions(":8080",
+			testcase.If(false, testcase.WithDebug(true)),
+			testcase.Chain(testcase.WithRetries(1), testcase.If(true, testcase.WithTimeout(time.Minute))),
+		)
+
+		res := values(&opts)
+		assert.Equal(t, time.Minute, res["timeout"])
+		assert.Equal(t, 1, res["retries"])
+		assert.Equal(t, false, res["debug"])
+		assert.False(t, opts.IsSet(testcase.Fielddebug))
+	})
+}
+
+func TestOptionsGenericPresets(t *testing.T) {
+	opts := genericcase.NewOptions("key", genericcase.RetryTwice(), genericcase.Answer())
+
+	assert.True(t, opts.IsSet(genericcase.Fieldvalue))
+	assert.True(t, opts.IsSet(genericcase.Fieldretries))
+}
@@ -0,0 +1,111 @@
+package optionsgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
+	"gopkg.in/yaml.v3"
+)
+
+type schemaFile struct {
+	Struct     string         `yaml:"struct"`
+	Doc        string         `yaml:"doc"`
+	TypeParams string         `yaml:"type_params"`
+	Imports    []string       `yaml:"imports"`
+	Options    []schemaOption `yaml:"options"`
+}
+
+type schemaOption struct {
+	Name       string   `yaml:"name"`
+	Type       string   `yaml:"type"`
+	Doc        string   `yaml:"doc"`
+	Mandatory  bool     `yaml:"mandatory"`
+	Default    string   `yaml:"default"`
+	Validate   string   `yaml:"validate"`
+	Variadic   bool     `yaml:"variadic"`
+	Setter     string   `yaml:"setter"`
+	Aliases    []string `yaml:"aliases"`
+	Env        string   `yaml:"env"`
+	Key        string   `yaml:"key"`
+	Secret     bool     `yaml:"secret"`
+	Deprecated string   `yaml:"deprecated"`
+	Group      string   `yaml:"group"`
+	Standalone bool     `yaml:"standalone"`
+}
+
+// LoadSchema reads the spec of options from the YAML or JSON file:
+//
+//	struct: Options
+//	imports:
+//	  - time
+//	options:
+//	  - name: addr
+//	    type: string
+//	    mandatory: true
+//	  - name: timeout
+//	    type: time.Duration
+//	    default: 5s
+//	    validate: min=1s
+//
+// Imports are import paths, optionally prefixed by an alias and a space.
+func LoadSchema(filename string) (*optionspec.OptionSpec, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read schema: %w", err)
+	}
+
+	var schema schemaFile
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&schema); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+
+	spec := optionspec.New(schema.Struct)
+	spec.Doc = schema.Doc
+	spec.TypeParams = schema.TypeParams
+
+	for _, imp := range schema.Imports {
+		alias, path, ok := strings.Cut(strings.TrimSpace(imp), " ")
+		if !ok {
+			alias, path = "", alias
+		}
+
+		spec.Import(strings.TrimSpace(path), alias)
+	}
+
+	for _, opt := range schema.Options {
+		spec.Add(optionspec.OptionMeta{
+			Field: opt.Name,
+			Type:  opt.Type,
+			Doc:   opt.Doc,
+			TagOption: optionspec.TagOption{
+				Mandatory:  opt.Mandatory,
+				Validate:   opt.Validate,
+				Default:    opt.Default,
+				Variadic:   opt.Variadic,
+				Name:       opt.Setter,
+				Env:        opt.Env,
+				Key:        opt.Key,
+				Secret:     opt.Secret,
+				Aliases:    opt.Aliases,
+				Deprecated: opt.Deprecated,
+				Group:      opt.Group,
+				Standalone: opt.Standalone,
+			},
+		})
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	return spec, nil
+}
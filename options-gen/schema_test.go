@@ -0,0 +1,132 @@
+package optionsgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSchema(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "options.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(`struct: Options
+imports:
+  - time
+  - stdlog log
+options:
+  - name: addr
+    type: string
+    mandatory: true
+    doc: Address of the server.
+  - name: timeout
+    type: time.Duration
+    default: 5s
+    validate: min=1s
+  - name: retries
+    type: int
+    default: 3
+  - name: hosts
+    type: string
+    variadic: true
+    setter: Host
+`), 0o600))
+
+	spec, err := optionsgen.LoadSchema(filename)
+	require.NoError(t, err)
+
+	expected := optionspec.New("Options").
+		Import("time", "").
+		Import("log", "stdlog").
+		Add(optionspec.OptionMeta{
+			Field:     "addr",
+			Type:      "string",
+			Doc:       "Address of the server.",
+			TagOption: optionspec.TagOption{Mandatory: true},
+		})
+
+	timeout := optionspec.Option("timeout", "time.Duration")
+	timeout.TagOption.Default = "5s"
+	timeout.TagOption.Validate = "min=1s"
+
+	retries := optionspec.Option("retries", "int")
+	retries.TagOption.Default = "3"
+
+	hosts := optionspec.Option("hosts", "string")
+	hosts.TagOption.Variadic = true
+	hosts.TagOption.Name = "Host"
+
+	expected.Add(timeout, retries, hosts)
+	assert.Equal(t, expected, spec)
+
+	outputs, warnings, err := optionsgen.Generate(optionsgen.NewOptions(
+		optionsgen.WithVersion("qa-version"),
+		optionsgen.WithInFilename(filename),
+		optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+		optionsgen.WithPackageName("testcase"),
+		optionsgen.WithOptionSpec(spec),
+		optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+	))
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	require.Len(t, outputs, 1)
+
+	content := string(outputs[0].Content)
+	assert.Contains(t, content, "type Options struct {\n"+
+		"\t// Address of the server.\n"+
+		"\taddr    string        `option:\"mandatory\"`\n"+
+		"\ttimeout time.Duration `default:\"5s\" validate:\"min=1s\"`\n"+
+		"\tretries int           `default:\"3\"`\n"+
+		"\thosts   []string      `option:\"variadic=true,name=Host\"`\n"+
+		"}\n")
+	assert.Contains(t, content, "func WithHost(opt ...string) OptOptionsSetter {")
+
+	t.Run("invalid_schema", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "options.yaml")
+		require.NoError(t, os.WriteFile(filename, []byte("struct: Options\nunknown: true\n"), 0o600))
+
+		_, err := optionsgen.LoadSchema(filename)
+		require.ErrorContains(t, err, "parse schema")
+
+		require.NoError(t, os.WriteFile(filename, []byte("struct: Options\noptions:\n  - name: a\n    type: \"[\"\n"), 0o600))
+
+		_, err = optionsgen.LoadSchema(filename)
+		require.ErrorContains(t, err, "invalid type")
+	})
+
+	t.Run("checks_options", func(t *testing.T) {
+		t.Parallel()
+
+		generate := func(spec *optionspec.OptionSpec, defaults optionsgen.DefaultsFrom) error {
+			_, _, err := optionsgen.Generate(optionsgen.NewOptions(
+				optionsgen.WithVersion("qa-version"),
+				optionsgen.WithInFilename(filename),
+				optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+				optionsgen.WithPackageName("testcase"),
+				optionsgen.WithOptionSpec(spec),
+				optionsgen.WithDefaults(optionsgen.Defaults{From: defaults, Param: ""}),
+			))
+
+			return err
+		}
+
+		port := optionspec.Option("port", "int")
+		port.TagOption.Default = "http"
+		require.ErrorContains(t, generate(optionspec.New("Options").Add(port), optionsgen.DefaultsFromTag),
+			"bad default value")
+
+		port.TagOption.Default = "80"
+		require.ErrorContains(t, generate(optionspec.New("Options").Add(port), optionsgen.DefaultsFromNone),
+			"defaults are not read from the tag")
+		require.ErrorContains(t, generate(optionspec.New("Options").Add(port), optionsgen.DefaultsFromVar),
+			"cannot be read from var")
+	})
+}
@@ -0,0 +1,64 @@
+package optionsgen_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-28-source"
+	"github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsSource(t *testing.T) {
+	envSetters, err := testcase.LoadOptionsFromEnv(func(key string) (string, bool) {
+		if key == "TIMEOUT" {
+			return "5s", true
+		}
+
+		return "", false
+	})
+	require.NoError(t, err)
+
+	opts := testcase.NewOptions(":8080", append(envSetters, testcase.WithPassword("qwerty"))...)
+
+	assert.Equal(t, optionsinfo.SourceMandatory, opts.Source(testcase.Fieldaddr))
+	assert.Equal(t, optionsinfo.SourceSetter, opts.Source(testcase.Fieldpassword))
+	assert.Equal(t, optionsinfo.SourceEnv, opts.Source(testcase.Fieldtimeout))
+	assert.Equal(t, optionsinfo.SourceTag, opts.Source(testcase.Fieldretries))
+	assert.Equal(t, optionsinfo.SourceUnset, opts.Source(testcase.Fieldname))
+
+	assert.Equal(t, "Options{addr: :8080 (mandatory), password: *** (setter), timeout: 5s (env), "+
+		"retries: 3 (tag), name:  (unset)}", opts.String())
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("start", "opts", opts)
+	assert.Contains(t, buf.String(), "opts.timeout.value=5s opts.timeout.source=env")
+	assert.Contains(t, buf.String(), "opts.password.value=*** opts.password.source=setter")
+}
+
+func TestOptionsSourcePerInstance(t *testing.T) {
+	first := testcase.NewOptions(":8080", testcase.WithName("first"))
+	second := testcase.NewOptions(":8081", testcase.WithTimeout(time.Second))
+
+	assert.Equal(t, optionsinfo.SourceSetter, first.Source(testcase.Fieldname))
+	assert.Equal(t, optionsinfo.SourceTag, first.Source(testcase.Fieldtimeout))
+
+	assert.Equal(t, optionsinfo.SourceUnset, second.Source(testcase.Fieldname))
+	assert.Equal(t, optionsinfo.SourceSetter, second.Source(testcase.Fieldtimeout))
+}
+
+func TestOptionsSourceCopy(t *testing.T) {
+	opts := testcase.NewOptions(":8080")
+
+	optsCopy := opts
+	testcase.WithName("copy")(&optsCopy)
+	testcase.WithRetries(5)(&optsCopy)
+
+	assert.Equal(t, optionsinfo.SourceUnset, opts.Source(testcase.Fieldname))
+	assert.Equal(t, optionsinfo.SourceTag, opts.Source(testcase.Fieldretries))
+	assert.Equal(t, optionsinfo.SourceSetter, optsCopy.Source(testcase.Fieldname))
+	assert.Equal(t, optionsinfo.SourceSetter, optsCopy.Source(testcase.Fieldretries))
+}
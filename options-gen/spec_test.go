@@ -0,0 +1,70 @@
+package optionsgen_test
+
+import (
+	"testing"
+	"time"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-27-spec"
+	testcasefunc "github.com/kazhuravlev/options-gen/options-gen/testdata/case-41-spec-defaults-func"
+	"github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+	"github.com/kazhuravlev/options-gen/pkg/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsSpec(t *testing.T) {
+	spec := testcase.OptionsSpec()
+	require.Len(t, spec, 4)
+
+	assert.Equal(t, optionsinfo.FieldInfo{
+		Name:      "timeout",
+		Setter:    "WithTimeout",
+		Type:      "time.Duration",
+		Default:   "3s",
+		Mandatory: false,
+		Variadic:  false,
+		Secret:    false,
+		Validate:  "min=1s",
+		Doc:       "Timeout for requests.",
+	}, spec[2])
+	assert.True(t, spec[0].Mandatory)
+	assert.Empty(t, spec[0].Setter)
+	assert.Equal(t, "[]string", spec[3].Type)
+}
+
+func TestOptionsSpecDefaultsFunc(t *testing.T) {
+	spec := testcasefunc.OptionsSpec()
+	require.Len(t, spec, 3)
+
+	assert.Empty(t, spec[0].Default)
+	assert.Equal(t, "getDefaultOptions().timeout", spec[1].Default)
+	assert.Equal(t, "getDefaultOptions().retries", spec[2].Default)
+}
+
+func TestOptionsSpecValues(t *testing.T) {
+	opts := testcase.NewOptions(":8080", testcase.WithPassword("qwerty"), testcase.WithLabels("a", "b"))
+
+	values := make(map[string]any)
+	for info, value := range opts.SpecValues() {
+		values[info.Name] = value
+	}
+
+	assert.Equal(t, map[string]any{
+		"addr":     ":8080",
+		"password": redact.Mask,
+		"timeout":  3 * time.Second,
+		"labels":   []string{"a", "b"},
+	}, values)
+
+	t.Run("stops iteration", func(t *testing.T) {
+		var names []string
+		for info := range opts.SpecValues() {
+			names = append(names, info.Name)
+			if len(names) == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"addr", "password"}, names)
+	})
+}
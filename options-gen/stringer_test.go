@@ -0,0 +1,42 @@
+package optionsgen_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	testcase "github.com/kazhuravlev/options-gen/options-gen/testdata/case-26-stringer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsString(t *testing.T) {
+	opts := testcase.NewOptions(":8080", "qwerty",
+		testcase.WithToken([]byte("secret-token")),
+		testcase.WithTags([]string{"a", "b"}),
+		testcase.WithOnError(func(error) {}),
+		testcase.WithHandler(http.NotFoundHandler()),
+	)
+
+	const want = "Options{addr: :8080, password: ***, token: ***, timeout: 0s, tags: [2 items], " +
+		"labels: [0 items], headers: [0 items], onError: set, events: unset, client: unset, handler: set}"
+	assert.Equal(t, want, opts.String())
+	assert.Equal(t, want, fmt.Sprintf("%v", opts))
+	assert.NotContains(t, fmt.Sprintf("%+v", opts), "qwerty")
+}
+
+func TestOptionsLogValue(t *testing.T) {
+	opts := testcase.NewOptions(":8080", "qwerty", testcase.WithLabels("a"))
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("start", "opts", opts)
+
+	out := buf.String()
+	assert.Contains(t, out, "opts.addr=:8080")
+	assert.Contains(t, out, "opts.password=***")
+	assert.Contains(t, out, `opts.labels="[1 items]"`)
+	assert.Contains(t, out, "opts.onError=unset")
+	assert.Contains(t, out, "opts.client=unset")
+	assert.NotContains(t, out, "qwerty")
+}
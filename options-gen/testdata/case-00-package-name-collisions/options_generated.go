@@ -8,6 +8,11 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - F1
 func NewOptions(
 	F1 http.Client,
 	options ...OptOptionsSetter,
@@ -4,6 +4,7 @@ package testcase
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options and applies the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
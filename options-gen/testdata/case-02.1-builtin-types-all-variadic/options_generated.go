@@ -11,6 +11,25 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - valInt
+//   - valInt8
+//   - valInt16
+//   - valInt32
+//   - valInt64
+//   - valUInt
+//   - valUInt8
+//   - valUInt16
+//   - valUInt32
+//   - valUInt64
+//   - valFloat32
+//   - valFloat64
+//   - valString
+//   - valBytes
+//   - valBool
 func NewOptions(
 	valInt int,
 	valInt8 int8,
@@ -56,15 +75,22 @@ func NewOptions(
 }
 
 // optValInt docstring-1
+//
+// Validation: required.
 func WithOptValInt(opt int) OptOptionsSetter {
 	return func(o *Options) { o.optValInt = opt }
 }
 
 // docstring-2
+//
+// Validation: required.
 func WithOptValInt8(opt int8) OptOptionsSetter {
 	return func(o *Options) { o.optValInt8 = opt }
 }
 
+// WithOptValInt16 sets optValInt16.
+//
+// Validation: required.
 func WithOptValInt16(opt int16) OptOptionsSetter {
 	return func(o *Options) { o.optValInt16 = opt }
 }
@@ -72,6 +98,8 @@ func WithOptValInt16(opt int16) OptOptionsSetter {
 // Just
 // a
 // comment
+//
+// Validation: required.
 func WithOptValInt32(opt int32) OptOptionsSetter {
 	return func(o *Options) { o.optValInt32 = opt }
 }
@@ -79,46 +107,79 @@ func WithOptValInt32(opt int32) OptOptionsSetter {
 // Just
 // a
 // comment
+//
+// Validation: required.
 func WithOptValInt64(opt int64) OptOptionsSetter {
 	return func(o *Options) { o.optValInt64 = opt }
 }
 
+// WithOptValUInt sets optValUInt.
+//
+// Validation: required.
 func WithOptValUInt(opt uint) OptOptionsSetter {
 	return func(o *Options) { o.optValUInt = opt }
 }
 
+// WithOptValUInt8 sets optValUInt8.
+//
+// Validation: required.
 func WithOptValUInt8(opt uint8) OptOptionsSetter {
 	return func(o *Options) { o.optValUInt8 = opt }
 }
 
+// WithOptValUInt16 sets optValUInt16.
+//
+// Validation: required.
 func WithOptValUInt16(opt uint16) OptOptionsSetter {
 	return func(o *Options) { o.optValUInt16 = opt }
 }
 
+// WithOptValUInt32 sets optValUInt32.
+//
+// Validation: required.
 func WithOptValUInt32(opt uint32) OptOptionsSetter {
 	return func(o *Options) { o.optValUInt32 = opt }
 }
 
+// WithOptValUInt64 sets optValUInt64.
+//
+// Validation: required.
 func WithOptValUInt64(opt uint64) OptOptionsSetter {
 	return func(o *Options) { o.optValUInt64 = opt }
 }
 
+// WithOptValFloat32 sets optValFloat32.
+//
+// Validation: required.
 func WithOptValFloat32(opt float32) OptOptionsSetter {
 	return func(o *Options) { o.optValFloat32 = opt }
 }
 
+// WithOptValFloat64 sets optValFloat64.
+//
+// Validation: required.
 func WithOptValFloat64(opt float64) OptOptionsSetter {
 	return func(o *Options) { o.optValFloat64 = opt }
 }
 
+// WithOptValString sets optValString.
+//
+// Validation: required.
 func WithOptValString(opt string) OptOptionsSetter {
 	return func(o *Options) { o.optValString = opt }
 }
 
+// WithOptValBytes sets optValBytes.
+//
+// Validation: required.
+// Variadic: values are appended to the current ones.
 func WithOptValBytes(opt ...byte) OptOptionsSetter {
 	return func(o *Options) { o.optValBytes = append(o.optValBytes, opt...) }
 }
 
+// WithOptValBool sets optValBool.
+//
+// Validation: required.
 func WithOptValBool(opt bool) OptOptionsSetter {
 	return func(o *Options) { o.optValBool = opt }
 }
@@ -9,6 +9,14 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - any
+//   - stringer
+//   - rWCloser
+//   - local
 func NewOptions(
 	any any,
 	stringer fmt.Stringer,
@@ -8,6 +8,15 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - fnTypeParam
+//   - fnParam
+//   - handlerFunc
+//   - middleware
+//   - local
 func NewOptions(
 	fnTypeParam FnType,
 	fnParam func(server *http.Server) error,
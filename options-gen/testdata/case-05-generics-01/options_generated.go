@@ -11,6 +11,12 @@ import (
 
 type OptOptionsSetter[T string] func(o *Options[T])
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - requiredKey
+//   - key
 func NewOptions[T string](
 	requiredKey T,
 	key T,
@@ -12,6 +12,14 @@ import (
 
 type OptOptionsSetter[KeyT int | string, TT any] func(o *Options[KeyT, TT])
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - requiredHandler
+//   - requiredKey
+//   - handler
+//   - key
 func NewOptions[KeyT int | string, TT any](
 	requiredHandler http.Handler,
 	requiredKey KeyT,
@@ -4,6 +4,7 @@ package testcase
 
 type CustomOpt[T string] func(o *Options[T])
 
+// NewOptions creates Options and applies the setters.
 func NewOptions[T string](
 	options ...CustomOpt[T],
 ) Options[T] {
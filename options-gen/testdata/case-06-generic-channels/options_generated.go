@@ -11,6 +11,12 @@ import (
 
 type OptOptionsSetter[T any] func(o *Options[T])
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - ch1
+//   - ch2
 func NewOptions[T any](
 	ch1 chan T,
 	ch2 <-chan T,
@@ -4,6 +4,7 @@ package testcase
 
 type OptOptionsSetter[A comparable, B, C any, D int | string, E []A, F, G []any] func(o *Options[A, B, C, D, E, F, G])
 
+// NewOptions creates Options and applies the setters.
 func NewOptions[A comparable, B, C any, D int | string, E []A, F, G []any](
 	options ...OptOptionsSetter[A, B, C, D, E, F, G],
 ) Options[A, B, C, D, E, F, G] {
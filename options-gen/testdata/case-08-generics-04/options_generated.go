@@ -4,6 +4,12 @@ package testcase
 
 type OptOptionsSetter[T comparable] func(o *Options[T])
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - d1
+//   - d2
 func NewOptions[T comparable](
 	d1 SomeData[T],
 	d2 *SomeData[T],
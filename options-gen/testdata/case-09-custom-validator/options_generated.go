@@ -11,6 +11,12 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - amount
+//   - age
 func NewOptions(
 	amount int,
 	age int,
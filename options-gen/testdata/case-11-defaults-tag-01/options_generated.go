@@ -14,6 +14,11 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the defaults, the mandatory options and
+// then the setters.
+//
+// Mandatory options:
+//   - rWCloser
 func NewOptions(
 	rWCloser io.ReadWriteCloser,
 	options ...OptOptionsSetter,
@@ -50,62 +55,110 @@ func WithOptStringer(opt fmt.Stringer) OptOptionsSetter {
 	return func(o *Options) { o.optStringer = opt }
 }
 
+// WithValInt sets valInt.
+//
+// Default: 1 (from tag).
 func WithValInt(opt int) OptOptionsSetter {
 	return func(o *Options) { o.valInt = opt }
 }
 
+// WithValInt8 sets valInt8.
+//
+// Default: 8 (from tag).
 func WithValInt8(opt int8) OptOptionsSetter {
 	return func(o *Options) { o.valInt8 = opt }
 }
 
+// WithValInt16 sets valInt16.
+//
+// Default: 16 (from tag).
 func WithValInt16(opt int16) OptOptionsSetter {
 	return func(o *Options) { o.valInt16 = opt }
 }
 
+// WithValInt32 sets valInt32.
+//
+// Default: 32 (from tag).
 func WithValInt32(opt int32) OptOptionsSetter {
 	return func(o *Options) { o.valInt32 = opt }
 }
 
+// WithValInt64 sets valInt64.
+//
+// Default: 64 (from tag).
 func WithValInt64(opt int64) OptOptionsSetter {
 	return func(o *Options) { o.valInt64 = opt }
 }
 
+// WithValUInt sets valUInt.
+//
+// Default: 11 (from tag).
 func WithValUInt(opt uint) OptOptionsSetter {
 	return func(o *Options) { o.valUInt = opt }
 }
 
+// WithValUInt8 sets valUInt8.
+//
+// Default: 88 (from tag).
+// Validation: min=50.
 func WithValUInt8(opt uint8) OptOptionsSetter {
 	return func(o *Options) { o.valUInt8 = opt }
 }
 
+// WithValUInt16 sets valUInt16.
+//
+// Default: 1616 (from tag).
 func WithValUInt16(opt uint16) OptOptionsSetter {
 	return func(o *Options) { o.valUInt16 = opt }
 }
 
+// WithValUInt32 sets valUInt32.
+//
+// Default: 3232 (from tag).
 func WithValUInt32(opt uint32) OptOptionsSetter {
 	return func(o *Options) { o.valUInt32 = opt }
 }
 
+// WithValUInt64 sets valUInt64.
+//
+// Default: 6464 (from tag).
 func WithValUInt64(opt uint64) OptOptionsSetter {
 	return func(o *Options) { o.valUInt64 = opt }
 }
 
+// WithValFloat32 sets valFloat32.
+//
+// Default: 32.32 (from tag).
 func WithValFloat32(opt float32) OptOptionsSetter {
 	return func(o *Options) { o.valFloat32 = opt }
 }
 
+// WithValFloat64 sets valFloat64.
+//
+// Default: 64.64 (from tag).
 func WithValFloat64(opt float64) OptOptionsSetter {
 	return func(o *Options) { o.valFloat64 = opt }
 }
 
+// WithValDuration sets valDuration.
+//
+// Default: 3s (from tag).
+// Validation: min=100ms,max=30s.
 func WithValDuration(opt time.Duration) OptOptionsSetter {
 	return func(o *Options) { o.valDuration = opt }
 }
 
+// WithValString sets valString.
+//
+// Default: golang (from tag).
+// Validation: required.
 func WithValString(opt string) OptOptionsSetter {
 	return func(o *Options) { o.valString = opt }
 }
 
+// WithValBool sets valBool.
+//
+// Default: true (from tag).
 func WithValBool(opt bool) OptOptionsSetter {
 	return func(o *Options) { o.valBool = opt }
 }
@@ -12,6 +12,7 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the defaults and then the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
@@ -30,18 +31,34 @@ func NewOptions(
 	return o
 }
 
+// WithSomePingPeriod sets pingPeriod.
+//
+// Default: 3s (from tag).
+// Validation: min=100ms,max=30s.
 func WithSomePingPeriod(opt time.Duration) OptOptionsSetter {
 	return func(o *Options) { o.pingPeriod = opt }
 }
 
+// WithSomeName sets name.
+//
+// Default: unknown (from tag).
+// Validation: required.
 func WithSomeName(opt string) OptOptionsSetter {
 	return func(o *Options) { o.name = opt }
 }
 
+// WithSomeMaxAttempts sets maxAttempts.
+//
+// Default: 10 (from tag).
+// Validation: min=1,max=10.
 func WithSomeMaxAttempts(opt int) OptOptionsSetter {
 	return func(o *Options) { o.maxAttempts = opt }
 }
 
+// WithSomeEps sets eps.
+//
+// Default: 0.0001 (from tag).
+// Validation: gt=0.
 func WithSomeEps(opt float32) OptOptionsSetter {
 	return func(o *Options) { o.eps = opt }
 }
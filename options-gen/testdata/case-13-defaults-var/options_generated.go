@@ -13,6 +13,7 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the defaults and then the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
@@ -30,18 +31,34 @@ func NewOptions(
 	return o
 }
 
+// WithXXXName sets name.
+//
+// Default: defaultOptions.name.
+// Validation: required.
 func WithXXXName(opt string) OptOptionsSetter {
 	return func(o *Options) { o.name = opt }
 }
 
+// WithXXXTimeout sets timeout.
+//
+// Default: defaultOptions.timeout.
+// Validation: min=100ms,max=30s.
 func WithXXXTimeout(opt time.Duration) OptOptionsSetter {
 	return func(o *Options) { o.timeout = opt }
 }
 
+// WithXXXMaxAttempts sets maxAttempts.
+//
+// Default: defaultOptions.maxAttempts.
+// Validation: min=1,max=10.
 func WithXXXMaxAttempts(opt int) OptOptionsSetter {
 	return func(o *Options) { o.maxAttempts = opt }
 }
 
+// WithXXXHttpClient sets httpClient.
+//
+// Default: defaultOptions.httpClient.
+// Validation: gt=0.
 func WithXXXHttpClient(opt *http.Client) OptOptionsSetter {
 	return func(o *Options) { o.httpClient = opt }
 }
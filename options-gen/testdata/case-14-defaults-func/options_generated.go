@@ -13,6 +13,7 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the defaults and then the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
@@ -31,18 +32,34 @@ func NewOptions(
 	return o
 }
 
+// WithSomeName sets name.
+//
+// Default: getDefaults().name.
+// Validation: required.
 func WithSomeName(opt string) OptOptionsSetter {
 	return func(o *Options) { o.name = opt }
 }
 
+// WithSomeTimeout sets timeout.
+//
+// Default: getDefaults().timeout.
+// Validation: min=100ms,max=30s.
 func WithSomeTimeout(opt time.Duration) OptOptionsSetter {
 	return func(o *Options) { o.timeout = opt }
 }
 
+// WithSomeMaxAttempts sets maxAttempts.
+//
+// Default: getDefaults().maxAttempts.
+// Validation: min=1,max=10.
 func WithSomeMaxAttempts(opt int) OptOptionsSetter {
 	return func(o *Options) { o.maxAttempts = opt }
 }
 
+// WithSomeHttpClient sets httpClient.
+//
+// Default: getDefaults().httpClient.
+// Validation: gt=0.
 func WithSomeHttpClient(opt *http.Client) OptOptionsSetter {
 	return func(o *Options) { o.httpClient = opt }
 }
@@ -4,6 +4,7 @@ package testcase
 
 type OptOptionsSetter func(o *Options)
 
+// newOptions creates Options and applies the setters.
 func newOptions(
 	options ...OptOptionsSetter,
 ) Options {
@@ -8,6 +8,7 @@ import (
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options and applies the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
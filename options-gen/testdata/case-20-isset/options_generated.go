@@ -48,6 +48,25 @@ var optIsSet = [30]bool{}
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - valInt
+//   - valInt8
+//   - valInt16
+//   - valInt32
+//   - valInt64
+//   - valUInt
+//   - valUInt8
+//   - valUInt16
+//   - valUInt32
+//   - valUInt64
+//   - valFloat32
+//   - valFloat64
+//   - valString
+//   - valBytes
+//   - valBool
 func NewOptions(
 	valInt int,
 	valInt8 int8,
@@ -111,6 +130,9 @@ func NewOptions(
 }
 
 // optValInt docstring-1
+//
+// Validation: required.
+// Check: IsSet(FieldoptValInt).
 func WithOptValInt(opt int) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValInt = opt
@@ -119,6 +141,9 @@ func WithOptValInt(opt int) OptOptionsSetter {
 }
 
 // docstring-2
+//
+// Validation: required.
+// Check: IsSet(FieldoptValInt8).
 func WithOptValInt8(opt int8) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValInt8 = opt
@@ -126,6 +151,10 @@ func WithOptValInt8(opt int8) OptOptionsSetter {
 	}
 }
 
+// WithOptValInt16 sets optValInt16.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValInt16).
 func WithOptValInt16(opt int16) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValInt16 = opt
@@ -136,6 +165,9 @@ func WithOptValInt16(opt int16) OptOptionsSetter {
 // Just
 // a
 // comment
+//
+// Validation: required.
+// Check: IsSet(FieldoptValInt32).
 func WithOptValInt32(opt int32) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValInt32 = opt
@@ -146,6 +178,9 @@ func WithOptValInt32(opt int32) OptOptionsSetter {
 // Just
 // a
 // comment
+//
+// Validation: required.
+// Check: IsSet(FieldoptValInt64).
 func WithOptValInt64(opt int64) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValInt64 = opt
@@ -153,6 +188,10 @@ func WithOptValInt64(opt int64) OptOptionsSetter {
 	}
 }
 
+// WithOptValUInt sets optValUInt.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValUInt).
 func WithOptValUInt(opt uint) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValUInt = opt
@@ -160,6 +199,10 @@ func WithOptValUInt(opt uint) OptOptionsSetter {
 	}
 }
 
+// WithOptValUInt8 sets optValUInt8.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValUInt8).
 func WithOptValUInt8(opt uint8) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValUInt8 = opt
@@ -167,6 +210,10 @@ func WithOptValUInt8(opt uint8) OptOptionsSetter {
 	}
 }
 
+// WithOptValUInt16 sets optValUInt16.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValUInt16).
 func WithOptValUInt16(opt uint16) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValUInt16 = opt
@@ -174,6 +221,10 @@ func WithOptValUInt16(opt uint16) OptOptionsSetter {
 	}
 }
 
+// WithOptValUInt32 sets optValUInt32.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValUInt32).
 func WithOptValUInt32(opt uint32) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValUInt32 = opt
@@ -181,6 +232,10 @@ func WithOptValUInt32(opt uint32) OptOptionsSetter {
 	}
 }
 
+// WithOptValUInt64 sets optValUInt64.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValUInt64).
 func WithOptValUInt64(opt uint64) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValUInt64 = opt
@@ -188,6 +243,10 @@ func WithOptValUInt64(opt uint64) OptOptionsSetter {
 	}
 }
 
+// WithOptValFloat32 sets optValFloat32.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValFloat32).
 func WithOptValFloat32(opt float32) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValFloat32 = opt
@@ -195,6 +254,10 @@ func WithOptValFloat32(opt float32) OptOptionsSetter {
 	}
 }
 
+// WithOptValFloat64 sets optValFloat64.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValFloat64).
 func WithOptValFloat64(opt float64) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValFloat64 = opt
@@ -202,6 +265,10 @@ func WithOptValFloat64(opt float64) OptOptionsSetter {
 	}
 }
 
+// WithOptValString sets optValString.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValString).
 func WithOptValString(opt string) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValString = opt
@@ -209,6 +276,10 @@ func WithOptValString(opt string) OptOptionsSetter {
 	}
 }
 
+// WithOptValBytes sets optValBytes.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValBytes).
 func WithOptValBytes(opt []byte) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValBytes = opt
@@ -216,6 +287,10 @@ func WithOptValBytes(opt []byte) OptOptionsSetter {
 	}
 }
 
+// WithOptValBool sets optValBool.
+//
+// Validation: required.
+// Check: IsSet(FieldoptValBool).
 func WithOptValBool(opt bool) OptOptionsSetter {
 	return func(o *Options) {
 		o.optValBool = opt
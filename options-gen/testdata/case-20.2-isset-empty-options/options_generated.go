@@ -10,6 +10,7 @@ var optIsSet = [0]bool{}
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options and applies the setters.
 func NewOptions(
 	options ...OptOptionsSetter,
 ) Options {
@@ -4,6 +4,11 @@ package testcase
 
 type OptOptionsSetter func(o *Options)
 
+// NewOptions creates Options: applies the mandatory options and then the
+// setters.
+//
+// Mandatory options:
+//   - TLS
 func NewOptions(
 	TLS bool,
 	options ...OptOptionsSetter,
@@ -0,0 +1,15 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options struct {
+	name        string        `option:"mandatory"`
+	pingPeriod  time.Duration `validate:"min=100ms,max=30s"`
+	title       string        `validate:"required"`
+	maxAttempts int           `validate:"min=1,max=10"`
+	eps         float32       `validate:"gt=0"`
+	debug       bool
+	comment     string
+}
@@ -0,0 +1,17 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	httpClient *http.Client  `option:"mandatory"`
+	timeout    time.Duration `env:"TIMEOUT" default:"3s"`
+	retries    int8          `env:"RETRIES" validate:"min=1"`
+	ratio      float32       `env:"RATIO"`
+	debug      bool          `env:"DEBUG"`
+	name       string        `env:"NAME"`
+	pin        int           `env:"PIN" option:"secret"`
+	tags       []string
+}
@@ -0,0 +1,19 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	httpClient *http.Client `option:"mandatory"`
+	// Timeout for each request.
+	timeout time.Duration `default:"3s"`
+	// How many times the request
+	// will be retried.
+	maxRetries int8 `validate:"min=1"`
+	debug      bool
+	listenAddr string `default:":8080"`
+	tags       []string
+	handler    http.Handler
+}
@@ -0,0 +1,15 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	httpClient *http.Client      `option:"mandatory"`
+	timeout    time.Duration     `default:"3s"`
+	maxRetries int8              `validate:"min=1"`
+	listenAddr string            `key:"listen"`
+	labels     []string          `option:"variadic=true"`
+	headers    map[string]string `validate:"required"`
+}
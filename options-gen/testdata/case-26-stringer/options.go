@@ -0,0 +1,20 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	addr     string `option:"mandatory"`
+	password string `option:"mandatory,secret"`
+	token    []byte `option:"secret"`
+	timeout  time.Duration
+	tags     []string
+	labels   []string `option:"variadic=true"`
+	headers  map[string]string
+	onError  func(error)
+	events   chan<- string
+	client   *http.Client
+	handler  http.Handler
+}
@@ -0,0 +1,15 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options struct {
+	// Address to listen on.
+	addr string `option:"mandatory" validate:"required,hostname_port"`
+	// Password for the admin user.
+	password string `option:"secret"`
+	// Timeout for requests.
+	timeout time.Duration `default:"3s" validate:"min=1s"`
+	labels  []string      `option:"variadic=true"`
+}
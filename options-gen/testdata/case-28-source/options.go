@@ -0,0 +1,16 @@
+package testcase
+
+import (
+	"time"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+)
+
+type Options struct {
+	sources  optionsinfo.Sources
+	addr     string        `option:"mandatory"`
+	password string        `option:"secret" env:"PASSWORD"`
+	timeout  time.Duration `default:"3s" env:"TIMEOUT"`
+	retries  int           `default:"3"`
+	name     string
+}
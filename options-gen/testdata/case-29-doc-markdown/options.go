@@ -0,0 +1,18 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	// HTTP client for the requests.
+	httpClient *http.Client `option:"mandatory" validate:"required"`
+	// Request timeout.
+	// Applied to every request separately.
+	timeout time.Duration `default:"3s" validate:"min=1s"`
+	// Log level: debug|info|error.
+	level   string   `default:"info" validate:"oneof=debug info error"`
+	retries int      `default:"3"`
+	labels  []string `option:"variadic=true"`
+}
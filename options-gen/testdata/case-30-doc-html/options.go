@@ -0,0 +1,22 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options struct {
+	// Address to listen on, for example <host>:<port>.
+	addr string `option:"mandatory"`
+	// Timeout for requests.
+	timeout time.Duration
+	name    string
+	headers map[string]string
+}
+
+var defaultOptions = Options{
+	timeout: 3 * time.Second,
+	name:    "server",
+	headers: map[string]string{
+		"X-Server": "options-gen",
+	},
+}
@@ -0,0 +1,24 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	// Address to listen on.
+	listenAddr string `option:"mandatory" key:"listen" validate:"required,hostname_port"`
+	// Public URL of the service.
+	publicURL  string        `validate:"required,url"`
+	adminEmail string        `validate:"omitempty,email"`
+	name       string        `default:"server" validate:"min=3,max=32"`
+	level      string        `default:"info" validate:"oneof=debug info error"`
+	timeout    time.Duration `default:"3s" validate:"min=1s"`
+	retries    int           `default:"3" validate:"gte=0,lt=10"`
+	workers    uint8
+	ratio      float64  `validate:"gt=0,lte=1"`
+	debug      bool     `default:"true"`
+	tags       []string `validate:"max=5,dive,required"`
+	headers    map[string]string
+	client     *http.Client
+}
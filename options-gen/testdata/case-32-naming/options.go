@@ -0,0 +1,16 @@
+package testcase
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+type Options struct {
+	httpClient *http.Client `option:"mandatory"`
+	tlsConfig  *tls.Config
+	url        string
+	userId     string
+	grpcAddr   string
+	apiKeys    []string `option:"variadic=true"`
+	timeout    int      `option:"name=Deadline"`
+}
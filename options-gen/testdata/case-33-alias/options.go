@@ -0,0 +1,11 @@
+package testcase
+
+type Options[T any] struct {
+	addr string `option:"mandatory"`
+	// Request timeout in seconds.
+	timeout int      `option:"name=RequestTimeout,alias=Timeout,alias=Deadline"`
+	hosts   []string `option:"variadic=true,alias=Servers"`
+	value   T        `option:"alias=Payload"`
+	// Number of retries.
+	retries int `option:"deprecated=retries are handled by the transport"`
+}
@@ -0,0 +1,18 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options struct {
+	addr string `option:"mandatory"`
+	// Path to the TLS certificate.
+	certFile string `option:"group=TLS"`
+	// Path to the TLS key.
+	keyFile    string        `option:"group=TLS"`
+	minBackoff time.Duration `option:"group=Backoff" default:"100ms"`
+	maxBackoff time.Duration `option:"group=Backoff,standalone" default:"10s"`
+	username   string        `option:"group=Credentials"`
+	password   string        `option:"group=Credentials"`
+	scopes     []string      `option:"group=Credentials,variadic=true"`
+}
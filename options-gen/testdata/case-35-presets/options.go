@@ -0,0 +1,12 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options struct {
+	addr    string        `option:"mandatory"`
+	timeout time.Duration `default:"3s"`
+	retries int           `default:"3"`
+	debug   bool
+}
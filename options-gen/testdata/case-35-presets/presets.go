@@ -0,0 +1,19 @@
+package testcase
+
+import (
+	"time"
+)
+
+// Settings for the production environment.
+//
+// options-gen:preset
+var productionDefaults = []OptOptionsSetter{
+	WithTimeout(10 * time.Second),
+	WithRetries(5),
+}
+
+// options-gen:preset ForTesting
+var testingOptions = Options{
+	timeout: time.Second,
+	debug:   true,
+}
@@ -0,0 +1,15 @@
+package testcase
+
+import (
+	"time"
+)
+
+type Options[T any] struct {
+	addr string `option:"mandatory" validate:"required"`
+	// Request timeout.
+	timeout  time.Duration `default:"3s" validate:"min=1s" option:"alias=Deadline"`
+	labels   []string      `option:"variadic=true"`
+	value    T
+	certFile string `option:"group=TLS"`
+	keyFile  string `option:"group=TLS"`
+}
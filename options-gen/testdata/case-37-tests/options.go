@@ -0,0 +1,21 @@
+package testcase
+
+import (
+	"net/http"
+	"time"
+)
+
+type Options struct {
+	addr     string        `option:"mandatory" validate:"required"`
+	timeout  time.Duration `default:"5s"`
+	retries  int           `default:"3"`
+	ratio    float64       `default:"0.25"`
+	verbose  bool          `default:"true"`
+	workers  int           `default:"7"`
+	user     string        `validate:"required"`
+	limit    uint8         `validate:"max=100"`
+	tags     []string      `option:"variadic=true"`
+	certFile string        `option:"group=TLS"`
+	keyFile  string        `option:"group=TLS"`
+	client   *http.Client
+}
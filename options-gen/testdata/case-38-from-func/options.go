@@ -0,0 +1,18 @@
+package testcase
+
+import (
+	"log/slog"
+	"time"
+)
+
+type Conn struct{}
+
+// Dial connects to the server.
+//
+// options-gen:func mandatory=1
+// options-gen:param addr validate:"required"
+// options-gen:param timeout default:"5s" validate:"min=1s"
+// options-gen:param retries default:"3"
+func Dial(addr string, timeout time.Duration, retries int, logger *slog.Logger, tags ...string) (*Conn, error) {
+	return &Conn{}, nil
+}
@@ -0,0 +1,7 @@
+package testcase
+
+type Options[K comparable, V any] struct {
+	key     K `option:"mandatory"`
+	value   V
+	retries int `default:"3"`
+}
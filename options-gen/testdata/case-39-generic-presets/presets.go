@@ -0,0 +1,11 @@
+package testcase
+
+// options-gen:preset
+var retryTwice = []OptOptionsSetter[string, int]{
+	WithRetries[string, int](2),
+}
+
+// options-gen:preset Answer
+var answer = Options[string, int]{
+	value: 42,
+}
@@ -0,0 +1,7 @@
+package testcase
+
+type Options struct {
+	greeting string `default:"say \"hi\""`
+	path     string `default:"C:\\temp"`
+	pattern  string `default:"\\d+"`
+}
@@ -0,0 +1,17 @@
+package testcase
+
+import "time"
+
+type Options struct {
+	addr    string `option:"mandatory"`
+	timeout time.Duration
+	retries int
+}
+
+func getDefaultOptions() Options {
+	return Options{
+		addr:    "",
+		timeout: 3 * time.Second,
+		retries: 2,
+	}
+}
@@ -0,0 +1,9 @@
+package testcase
+
+// Split splits the string at the separator.
+//
+// options-gen:func mandatory=1
+// options-gen:param arg1 default:"," validate:"required"
+func Split(string, string) (string, string) {
+	return "", ""
+}
@@ -0,0 +1,45 @@
+package optionsgen_test
+
+import (
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/kazhuravlev/options-gen/pkg/optionsgentest"
+)
+
+// TestOwnOptionsUpToDate checks that options of the generator itself are
+// generated by the in-tree generator, see go:generate directives.
+func TestOwnOptionsUpToDate(t *testing.T) {
+	t.Parallel()
+
+	// NOTE: go run stamps the development version.
+	const version = "(devel)"
+
+	t.Run("options-gen", func(t *testing.T) {
+		t.Parallel()
+
+		optionsgentest.AssertUpToDate(t, optionsgen.NewOptions(
+			optionsgen.WithVersion(version),
+			optionsgen.WithInFilename("options.go"),
+			optionsgen.WithOutFilename("options_generated.go"),
+			optionsgen.WithStructName("Options"),
+			optionsgen.WithPackageName("optionsgen"),
+			optionsgen.WithAllVariadic(true),
+			optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromVar, Param: ""}),
+			optionsgen.WithNaming(optionsgen.NamingGo),
+		))
+	})
+
+	t.Run("generator", func(t *testing.T) {
+		t.Parallel()
+
+		optionsgentest.AssertUpToDate(t, optionsgen.NewOptions(
+			optionsgen.WithVersion(version),
+			optionsgen.WithInFilename("../internal/generator/options.go"),
+			optionsgen.WithOutFilename("../internal/generator/options_generated.go"),
+			optionsgen.WithStructName("Options"),
+			optionsgen.WithPackageName("generator"),
+			optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: "default"}),
+		))
+	})
+}
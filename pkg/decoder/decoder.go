@@ -0,0 +1,78 @@
+package decoder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrUnknownKey = errors.New("unknown key")
+
+// Decode converts raw value into the value of type T. Raw value is usually
+// produced by json.Unmarshal or yaml.Unmarshal into map[string]any. Values that
+// are not directly assignable to T are converted through JSON. Durations also
+// can be set as strings like "3s".
+func Decode[T any](raw any) (T, error) {
+	var res T
+	if value, ok := raw.(T); ok {
+		return value, nil
+	}
+
+	if _, ok := any(res).(time.Duration); ok {
+		if str, ok := raw.(string); ok {
+			value, err := time.ParseDuration(str)
+			if err != nil {
+				return res, err //nolint:wrapcheck
+			}
+
+			return any(value).(T), nil //nolint:forcetypeassert
+		}
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return res, fmt.Errorf("cannot encode value: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return res, fmt.Errorf("cannot decode value: %w", err)
+	}
+
+	return res, nil
+}
+
+// JSONMap decodes JSON object into map. Numbers are kept as json.Number to not
+// lose the precision of big integers.
+func JSONMap(data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var res map[string]any
+	if err := dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("cannot decode json: %w", err)
+	}
+
+	return res, nil
+}
+
+// UnknownKeys returns sorted keys of m, which are not presented in known.
+func UnknownKeys(m map[string]any, known ...string) []string {
+	knownSet := make(map[string]struct{}, len(known))
+	for _, key := range known {
+		knownSet[key] = struct{}{}
+	}
+
+	var res []string
+	for key := range m {
+		if _, ok := knownSet[key]; !ok {
+			res = append(res, key)
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
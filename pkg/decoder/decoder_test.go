@@ -0,0 +1,83 @@
+package decoder_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kazhuravlev/options-gen/pkg/decoder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("assignable", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := decoder.Decode[string]("joe")
+		require.NoError(t, err)
+		assert.Equal(t, "joe", value)
+	})
+
+	t.Run("number", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := decoder.Decode[int8](float64(10))
+		require.NoError(t, err)
+		assert.Equal(t, int8(10), value)
+
+		bigValue, err := decoder.Decode[int64](json.Number("9007199254740993"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(9007199254740993), bigValue)
+
+		_, err = decoder.Decode[int8](float64(1000))
+		require.Error(t, err)
+
+		_, err = decoder.Decode[int](3.5)
+		require.Error(t, err)
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := decoder.Decode[time.Duration]("3s")
+		require.NoError(t, err)
+		assert.Equal(t, 3*time.Second, value)
+
+		_, err = decoder.Decode[time.Duration]("soon")
+		require.Error(t, err)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := decoder.Decode[[]string]([]any{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, value)
+
+		mapValue, err := decoder.Decode[map[string]int](map[string]any{"a": 1})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1}, mapValue)
+	})
+}
+
+func TestJSONMap(t *testing.T) {
+	t.Parallel()
+
+	res, err := decoder.JSONMap([]byte(`{"retries": 5, "name": "joe"}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"retries": json.Number("5"), "name": "joe"}, res)
+
+	_, err = decoder.JSONMap([]byte(`[1, 2]`))
+	require.Error(t, err)
+}
+
+func TestUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]any{"b": 1, "a": 2, "known": 3}
+	assert.Equal(t, []string{"a", "b"}, decoder.UnknownKeys(m, "known"))
+	assert.Nil(t, decoder.UnknownKeys(m, "a", "b", "known"))
+}
@@ -0,0 +1,121 @@
+package errors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kazhuravlev/options-gen/pkg/redact"
+)
+
+// ParseError is returned when a raw value (like environment variable) cannot
+// be parsed into the option field type.
+type ParseError struct {
+	// Name is a name of the value source. For example, the environment
+	// variable name.
+	Name  string
+	Value string
+	Err   error
+}
+
+func NewParseError(name, value string, err error) *ParseError {
+	if err == nil {
+		return nil
+	}
+
+	return &ParseError{
+		Name:  name,
+		Value: value,
+		Err:   err,
+	}
+}
+
+// NewSecretParseError is NewParseError for a secret value. The value is
+// masked in Value and in the message of err, which usually quotes the input.
+// err is still available with errors.Is and errors.As.
+func NewSecretParseError(name, value string, err error) *ParseError {
+	if err == nil {
+		return nil
+	}
+
+	return &ParseError{
+		Name:  name,
+		Value: redact.Mask,
+		Err:   &secretError{err: err, value: value},
+	}
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("(%s): cannot parse %q: %s", e.Name, e.Value, e.Err.Error())
+}
+
+func (e *ParseError) Is(err error) bool {
+	return errors.Is(e.Err, err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// secretError masks the secret value in the message of the wrapped error.
+type secretError struct {
+	err   error
+	value string
+}
+
+func (e *secretError) Error() string {
+	msg := e.err.Error()
+	if e.value == "" {
+		return msg
+	}
+
+	msg = strings.ReplaceAll(msg, strconv.Quote(e.value), strconv.Quote(redact.Mask))
+
+	return strings.ReplaceAll(msg, e.value, redact.Mask)
+}
+
+func (e *secretError) Unwrap() error {
+	return e.err
+}
+
+type ParseErrors []ParseError
+
+func (e ParseErrors) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+
+	buf := bytes.NewBufferString("ParseErrors: ")
+	buf.Grow(len(e) * 16) //nolint:mnd // just because
+	for i := range e {
+		buf.WriteString(e[i].Error())
+		if i != len(e)-1 {
+			buf.WriteString("; ")
+		}
+	}
+
+	return buf.String()
+}
+
+func (e ParseErrors) Errors() []ParseError {
+	errs := make([]ParseError, len(e))
+	copy(errs, e)
+
+	return errs
+}
+
+func (e *ParseErrors) Add(err *ParseError) {
+	if err != nil {
+		*e = append(*e, *err)
+	}
+}
+
+func (e ParseErrors) AsError() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
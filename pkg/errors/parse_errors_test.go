@@ -0,0 +1,56 @@
+package errors_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	errs := new(errors.ParseErrors)
+	assert.NoError(t, errs.AsError())
+	assert.Equal(t, "", errs.Error())
+
+	_, errParse := strconv.Atoi("ten")
+	errs.Add(errors.NewParseError("APP_RETRIES", "ten", errParse))
+	errs.Add(errors.NewParseError("APP_TIMEOUT", "", nil))
+
+	assert.Error(t, errs.AsError())
+	expErrStr := `ParseErrors: (APP_RETRIES): cannot parse "ten": strconv.Atoi: parsing "ten": invalid syntax`
+	assert.Equal(t, expErrStr, errs.Error())
+	assert.Len(t, errs.Errors(), 1)
+
+	var err errors.ParseErrors
+	assert.ErrorAs(t, errs.AsError(), &err)
+	assert.Equal(t, "APP_RETRIES", err.Errors()[0].Name)
+	assert.Equal(t, "ten", err.Errors()[0].Value)
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, errors.NewParseError("APP_RETRIES", "10", nil))
+
+	_, errParse := strconv.Atoi("ten")
+	err := errors.NewParseError("APP_RETRIES", "ten", errParse)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestSecretParseError(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, errors.NewSecretParseError("APP_PIN", "1234", nil))
+
+	_, errParse := strconv.Atoi("12ab")
+	err := errors.NewSecretParseError("APP_PIN", "12ab", errParse)
+	assert.Equal(t, `(APP_PIN): cannot parse "***": strconv.Atoi: parsing "***": invalid syntax`, err.Error())
+	assert.Equal(t, "***", err.Value)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, err, &numErr)
+}
@@ -0,0 +1,58 @@
+// Package optionsgentest helps to keep the generated code up to date with
+// plain go test.
+package optionsgentest
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// AssertUpToDate regenerates all files configured by opts in memory and
+// fails the test with a diff for each file, which differs from the file on
+// disk. The version of opts must be the same as the version written by
+// go:generate, because it is a part of the header.
+func AssertUpToDate(t testing.TB, opts optionsgen.Options) {
+	t.Helper()
+
+	outputs, _, err := optionsgen.Generate(opts)
+	if err != nil {
+		t.Fatalf("cannot generate options: %v", err)
+
+		return
+	}
+
+	for _, out := range outputs {
+		current, err := os.ReadFile(out.Filename)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("cannot read %s: %v", out.Filename, err)
+
+			continue
+		}
+
+		if bytes.Equal(current, out.Content) {
+			continue
+		}
+
+		t.Errorf("%s is out of date, run go generate:\n%s", out.Filename, diff(current, out.Content))
+	}
+}
+
+func diff(current, generated []byte) string {
+	res, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(generated)),
+		FromFile: "current",
+		ToFile:   "generated",
+		Context:  3, //nolint:mnd
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	return res
+}
@@ -0,0 +1,80 @@
+package optionsgentest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/kazhuravlev/options-gen/pkg/optionsgentest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder collects failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertUpToDate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := "package testcase\n\ntype Options struct {\n\taddr string `option:\"mandatory\"`\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "options.go"), []byte(source), 0o600))
+
+	opts := optionsgen.NewOptions(
+		optionsgen.WithVersion("qa-version"),
+		optionsgen.WithInFilename(filepath.Join(dir, "options.go")),
+		optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+		optionsgen.WithStructName("Options"),
+		optionsgen.WithPackageName("testcase"),
+		optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+	)
+
+	t.Run("missing", func(t *testing.T) { //nolint:paralleltest
+		rec := &recorder{TB: t}
+		optionsgentest.AssertUpToDate(rec, opts)
+		require.Len(t, rec.errors, 1)
+		assert.Contains(t, rec.errors[0], "options_generated.go is out of date")
+	})
+
+	t.Run("up_to_date", func(t *testing.T) { //nolint:paralleltest
+		require.NoError(t, optionsgen.Run(opts))
+
+		rec := &recorder{TB: t}
+		optionsgentest.AssertUpToDate(rec, opts)
+		assert.Empty(t, rec.errors)
+	})
+
+	t.Run("stale", func(t *testing.T) { //nolint:paralleltest
+		filename := filepath.Join(dir, "options_generated.go")
+		content, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filename, append(content, "// stale\n"...), 0o600))
+
+		rec := &recorder{TB: t}
+		optionsgentest.AssertUpToDate(rec, opts)
+		require.Len(t, rec.errors, 1)
+		assert.Contains(t, rec.errors[0], "-// stale")
+	})
+
+	t.Run("bad_options", func(t *testing.T) { //nolint:paralleltest
+		rec := &recorder{TB: t}
+		optionsgentest.AssertUpToDate(rec, optionsgen.NewOptions())
+		require.Len(t, rec.errors, 1)
+		assert.Contains(t, rec.errors[0], "cannot generate options")
+	})
+}
@@ -0,0 +1,79 @@
+package optionsinfo
+
+// OptionSource describes where the current value of the option came from.
+type OptionSource uint8
+
+const (
+	SourceUnset     OptionSource = iota // option was not set.
+	SourceTag                           // default value from the field tag.
+	SourceVar                           // defaults variable.
+	SourceFunc                          // defaults func.
+	SourceFile                          // defaults file, which was read at the generation time.
+	SourceMandatory                     // mandatory argument of the constructor.
+	SourceSetter                        // WithX setter.
+	SourceEnv                           // setter returned by Load<StructName>FromEnv.
+	SourceFlag                          // setter returned by Register<StructName>Flags.
+	SourceDecoded                       // setter returned by <StructName>FromMap or <StructName>FromJSON.
+)
+
+func (s OptionSource) String() string {
+	switch s {
+	case SourceUnset:
+		return "unset"
+	case SourceTag:
+		return "tag"
+	case SourceVar:
+		return "var"
+	case SourceFunc:
+		return "func"
+	case SourceFile:
+		return "file"
+	case SourceMandatory:
+		return "mandatory"
+	case SourceSetter:
+		return "setter"
+	case SourceEnv:
+		return "env"
+	case SourceFlag:
+		return "flag"
+	case SourceDecoded:
+		return "decoded"
+	default:
+		return "unknown"
+	}
+}
+
+// Sources keeps sources of options of one instance. With -with-source the
+// options struct should have a field of this type, so each instance reports
+// its own sources:
+//
+//	type Options struct {
+//		sources optionsinfo.Sources
+//		addr    string `option:"mandatory"`
+//	}
+//
+// Fields are identified by their indexes in the generated code. Copies of the
+// options struct share the recorded sources until one of them is changed.
+type Sources struct {
+	values []OptionSource
+}
+
+// Set records the source of the field. Values are copied on each change, so a
+// copy of the options struct does not change sources of the original.
+func (s *Sources) Set(field int, source OptionSource) {
+	values := make([]OptionSource, max(len(s.values), field+1))
+	copy(values, s.values)
+	values[field] = source
+
+	s.values = values
+}
+
+// Get returns the source of the field. It is SourceUnset for fields, which
+// were not set.
+func (s Sources) Get(field int) OptionSource {
+	if field < 0 || field >= len(s.values) {
+		return SourceUnset
+	}
+
+	return s.values[field]
+}
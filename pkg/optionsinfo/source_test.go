@@ -0,0 +1,51 @@
+package optionsinfo_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionsinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionSource_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "unset", optionsinfo.SourceUnset.String())
+	assert.Equal(t, "tag", optionsinfo.SourceTag.String())
+	assert.Equal(t, "mandatory", optionsinfo.SourceMandatory.String())
+	assert.Equal(t, "decoded", optionsinfo.SourceDecoded.String())
+	assert.Equal(t, "unknown", optionsinfo.OptionSource(255).String())
+}
+
+func TestSources(t *testing.T) {
+	t.Parallel()
+
+	var sources optionsinfo.Sources
+	assert.Equal(t, optionsinfo.SourceUnset, sources.Get(0))
+
+	sources.Set(2, optionsinfo.SourceEnv)
+	sources.Set(0, optionsinfo.SourceTag)
+	sources.Set(0, optionsinfo.SourceSetter)
+
+	assert.Equal(t, optionsinfo.SourceSetter, sources.Get(0))
+	assert.Equal(t, optionsinfo.SourceUnset, sources.Get(1))
+	assert.Equal(t, optionsinfo.SourceEnv, sources.Get(2))
+	assert.Equal(t, optionsinfo.SourceUnset, sources.Get(3))
+	assert.Equal(t, optionsinfo.SourceUnset, sources.Get(-1))
+}
+
+func TestSourcesCopy(t *testing.T) {
+	t.Parallel()
+
+	var sources optionsinfo.Sources
+	sources.Set(1, optionsinfo.SourceTag)
+
+	sourcesCopy := sources
+	sourcesCopy.Set(1, optionsinfo.SourceSetter)
+	sourcesCopy.Set(0, optionsinfo.SourceEnv)
+
+	assert.Equal(t, optionsinfo.SourceTag, sources.Get(1))
+	assert.Equal(t, optionsinfo.SourceUnset, sources.Get(0))
+	assert.Equal(t, optionsinfo.SourceSetter, sourcesCopy.Get(1))
+	assert.Equal(t, optionsinfo.SourceEnv, sourcesCopy.Get(0))
+}
@@ -0,0 +1,16 @@
+// Package optionsinfo contains types that are used by the code generated with
+// the -with-spec and -with-source flags.
+package optionsinfo
+
+// FieldInfo describes a single option of the options struct.
+type FieldInfo struct {
+	Name      string // field name as it is declared in the struct.
+	Setter    string // name of the setter function. Empty for mandatory options.
+	Type      string // field type as it is written in the source code.
+	Default   string // default value. With -defaults-from=var|func it is the expression, like defaults.field.
+	Mandatory bool   // option should be passed to the constructor.
+	Variadic  bool   // setter accepts variadic arguments.
+	Secret    bool   // option value is masked, see the secret tag.
+	Validate  string // go-playground/validator rule.
+	Doc       string // field comment as a single line.
+}
@@ -0,0 +1,300 @@
+// Package optionspec describes options of a struct for options-gen and other
+// generators. Parse reads the spec from the struct declared in Go source,
+// New builds it without source and Render generates options of the spec, the
+// same way as options-gen does for the struct declared with the same tags.
+//
+// The API of the package follows semantic versioning of the module and does
+// not depend on flags and output of the options-gen command.
+package optionspec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// OptionSpec is the options struct.
+type OptionSpec struct {
+	StructName string
+	// Doc is the comment of the struct without slashes.
+	Doc string
+	// TypeParams are type parameters of the struct, like "T any, K comparable".
+	TypeParams string
+	Options    []OptionMeta
+	Imports    []Import
+}
+
+// OptionMeta is a field of the options struct.
+type OptionMeta struct {
+	Field string
+	// Type is the type of the field. For variadic options it is the type of
+	// the element, the field is a slice of it.
+	Type string
+	// Doc is the comment of the field without slashes.
+	Doc       string
+	TagOption TagOption
+}
+
+// TagOption is what the tags of the field set.
+type TagOption struct {
+	Mandatory  bool
+	Validate   string
+	Default    string
+	Variadic   bool
+	Name       string
+	Env        string
+	Key        string
+	Secret     bool
+	Aliases    []string
+	Deprecated string
+	Group      string
+	Standalone bool
+}
+
+// Import is a package used by types of options.
+type Import struct {
+	Path  string
+	Alias string
+}
+
+// New returns the spec of the struct without options.
+func New(structName string) *OptionSpec {
+	return &OptionSpec{StructName: structName} //nolint:exhaustruct
+}
+
+// Option returns the optional option.
+func Option(field, typ string) OptionMeta {
+	return OptionMeta{Field: field, Type: typ} //nolint:exhaustruct
+}
+
+// Add appends options to the spec.
+func (s *OptionSpec) Add(options ...OptionMeta) *OptionSpec {
+	s.Options = append(s.Options, options...)
+
+	return s
+}
+
+// Import adds the import used by types of options. Alias can be empty.
+func (s *OptionSpec) Import(path, alias string) *OptionSpec {
+	s.Imports = append(s.Imports, Import{Path: path, Alias: alias})
+
+	return s
+}
+
+// Validate checks that the spec can be declared as a struct. Tags are checked
+// by options-gen while generating.
+func (s *OptionSpec) Validate() error {
+	if !token.IsIdentifier(s.StructName) {
+		return fmt.Errorf("invalid struct name `%s`", s.StructName)
+	}
+
+	if s.TypeParams != "" {
+		file, err := parser.ParseFile(token.NewFileSet(), "", "package p; type t["+s.TypeParams+"] struct{}", 0)
+		if err != nil || len(file.Decls) != 1 {
+			return fmt.Errorf("invalid type params `%s`", s.TypeParams)
+		}
+	}
+
+	if len(s.Options) == 0 {
+		return errors.New("no options")
+	}
+
+	fields := make(map[string]struct{}, len(s.Options))
+	for _, opt := range s.Options {
+		if !token.IsIdentifier(opt.Field) || opt.Field == "_" {
+			return fmt.Errorf("invalid field name `%s`", opt.Field)
+		}
+
+		if _, ok := fields[opt.Field]; ok {
+			return fmt.Errorf("duplicated field `%s`", opt.Field)
+		}
+
+		fields[opt.Field] = struct{}{}
+
+		if _, err := parser.ParseExpr(opt.Type); err != nil {
+			return fmt.Errorf("field `%s`: invalid type `%s`", opt.Field, opt.Type)
+		}
+
+		if err := opt.TagOption.validate(); err != nil {
+			return fmt.Errorf("field `%s`: %w", opt.Field, err)
+		}
+	}
+
+	imports := make(map[string]struct{}, len(s.Imports))
+	for _, imp := range s.Imports {
+		if imp.Path == "" || strings.ContainsAny(imp.Path, "\"` ") {
+			return fmt.Errorf("invalid import path `%s`", imp.Path)
+		}
+
+		if imp.Alias != "" && !token.IsIdentifier(imp.Alias) && imp.Alias != "_" && imp.Alias != "." {
+			return fmt.Errorf("invalid alias `%s` of import `%s`", imp.Alias, imp.Path)
+		}
+
+		if _, ok := imports[imp.Path]; ok {
+			return fmt.Errorf("duplicated import `%s`", imp.Path)
+		}
+
+		imports[imp.Path] = struct{}{}
+	}
+
+	return nil
+}
+
+// Source returns the file of the package packageName, which declares the
+// struct of the spec with tags. Default values are written into defaultTag,
+// they are dropped when defaultTag is empty.
+func (s *OptionSpec) Source(packageName, defaultTag string) ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("package " + packageName + "\n\n")
+
+	for _, imp := range s.Imports {
+		buf.WriteString("import " + imp.Alias + " " + strconv.Quote(imp.Path) + "\n")
+	}
+
+	buf.WriteString("\n" + comment(s.Doc) + "type " + s.StructName)
+
+	if s.TypeParams != "" {
+		buf.WriteString("[" + s.TypeParams + "]")
+	}
+
+	buf.WriteString(" struct {\n")
+
+	for _, opt := range s.Options {
+		buf.WriteString(comment(opt.Doc) + opt.Field + " " + opt.FieldType())
+
+		if tag := opt.TagOption.StructTag(defaultTag); tag != "" {
+			buf.WriteString(" `" + tag + "`")
+		}
+
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("cannot format struct: %w", err)
+	}
+
+	return src, nil
+}
+
+// FieldType returns the type of the struct field.
+func (o OptionMeta) FieldType() string {
+	if o.TagOption.Variadic {
+		return "[]" + o.Type
+	}
+
+	return o.Type
+}
+
+// StructTag returns the tag of the struct field without backquotes. Default
+// is written into defaultTag, it is dropped when defaultTag is empty.
+func (t TagOption) StructTag(defaultTag string) string {
+	var option []string
+	if t.Mandatory {
+		option = append(option, "mandatory")
+	}
+
+	if t.Variadic {
+		option = append(option, "variadic=true")
+	}
+
+	if t.Name != "" {
+		option = append(option, "name="+t.Name)
+	}
+
+	for _, alias := range t.Aliases {
+		option = append(option, "alias="+alias)
+	}
+
+	if t.Secret {
+		option = append(option, "secret")
+	}
+
+	if t.Group != "" {
+		option = append(option, "group="+t.Group)
+	}
+
+	if t.Standalone {
+		option = append(option, "standalone")
+	}
+
+	if t.Deprecated != "" {
+		deprecated := t.Deprecated
+		if strings.Contains(deprecated, ",") {
+			deprecated = "'" + deprecated + "'"
+		}
+
+		option = append(option, "deprecated="+deprecated)
+	}
+
+	var pairs []string
+
+	add := func(key, value string) {
+		if value != "" {
+			pairs = append(pairs, key+":"+strconv.Quote(value))
+		}
+	}
+
+	add("option", strings.Join(option, ","))
+
+	if defaultTag != "" {
+		add(defaultTag, t.Default)
+	}
+
+	add("validate", t.Validate)
+	add("env", t.Env)
+	add("key", t.Key)
+
+	return strings.Join(pairs, " ")
+}
+
+func (t TagOption) validate() error {
+	values := []string{t.Validate, t.Default, t.Env, t.Key, t.Name, t.Deprecated, t.Group}
+	values = append(values, t.Aliases...)
+
+	for _, value := range values {
+		if strings.Contains(value, "`") {
+			return fmt.Errorf("backquote in `%s`", value)
+		}
+	}
+
+	// Values of the option tag are separated by commas. The deprecation
+	// message with commas is quoted.
+	for _, value := range append([]string{t.Name, t.Group}, t.Aliases...) {
+		if strings.Contains(value, ",") {
+			return fmt.Errorf("comma in `%s`", value)
+		}
+	}
+
+	if strings.Contains(t.Deprecated, ",") && strings.Contains(t.Deprecated, "'") {
+		return fmt.Errorf("quote in `%s`, which contains commas", t.Deprecated)
+	}
+
+	return nil
+}
+
+// comment returns the comment with slashes, which ends with a newline.
+func comment(text string) string {
+	if text == "" {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+line, " ")
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,135 @@
+package optionspec_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *optionspec.OptionSpec {
+		return optionspec.New("Options").
+			Import("time", "").
+			Add(optionspec.Option("timeout", "time.Duration"))
+	}
+
+	require.NoError(t, valid().Validate())
+
+	generic := valid()
+	generic.TypeParams = "T any, K comparable"
+	require.NoError(t, generic.Validate())
+
+	tests := map[string]struct {
+		spec *optionspec.OptionSpec
+		err  string
+	}{
+		"struct_name": {
+			spec: optionspec.New("1Options").Add(optionspec.Option("a", "int")),
+			err:  "invalid struct name `1Options`",
+		},
+		"no_options": {
+			spec: optionspec.New("Options"),
+			err:  "no options",
+		},
+		"type_params": {
+			spec: &optionspec.OptionSpec{
+				StructName: "Options",
+				TypeParams: "T any] struct{}; type x[T any",
+				Options:    []optionspec.OptionMeta{optionspec.Option("a", "int")},
+			},
+			err: "invalid type params",
+		},
+		"field_name": {
+			spec: optionspec.New("Options").Add(optionspec.Option("a-b", "int")),
+			err:  "invalid field name `a-b`",
+		},
+		"duplicated_field": {
+			spec: optionspec.New("Options").Add(optionspec.Option("a", "int"), optionspec.Option("a", "string")),
+			err:  "duplicated field `a`",
+		},
+		"type": {
+			spec: optionspec.New("Options").Add(optionspec.Option("a", "map[string")),
+			err:  "field `a`: invalid type",
+		},
+		"backquote": {
+			spec: optionspec.New("Options").Add(optionspec.OptionMeta{
+				Field: "a", Type: "string", TagOption: optionspec.TagOption{Default: "`"},
+			}),
+			err: "field `a`: backquote",
+		},
+		"comma": {
+			spec: optionspec.New("Options").Add(optionspec.OptionMeta{
+				Field: "a", Type: "string", TagOption: optionspec.TagOption{Group: "B,C"},
+			}),
+			err: "field `a`: comma",
+		},
+		"quote": {
+			spec: optionspec.New("Options").Add(optionspec.OptionMeta{
+				Field: "a", Type: "string", TagOption: optionspec.TagOption{Deprecated: "use 'b', c"},
+			}),
+			err: "field `a`: quote",
+		},
+		"import": {
+			spec: valid().Import("time", "t"),
+			err:  "duplicated import `time`",
+		},
+		"alias": {
+			spec: valid().Import("log", "std-log"),
+			err:  "invalid alias `std-log`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorContains(t, test.spec.Validate(), test.err)
+		})
+	}
+}
+
+func TestStructTag(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, optionspec.TagOption{}.StructTag("default"))
+
+	tag := optionspec.TagOption{
+		Mandatory: true,
+		Validate:  "required",
+		Env:       "ADDR",
+		Secret:    true,
+	}
+	assert.Equal(t, `option:"mandatory,secret" validate:"required" env:"ADDR"`, tag.StructTag("default"))
+
+	tag = optionspec.TagOption{
+		Default:    `say "hi"`,
+		Variadic:   true,
+		Name:       "Host",
+		Aliases:    []string{"Server"},
+		Group:      "Net",
+		Standalone: true,
+		Deprecated: "use addr",
+		Key:        "host",
+	}
+	assert.Equal(t, `option:"variadic=true,name=Host,alias=Server,group=Net,standalone,deprecated=use addr" `+
+		`def:"say \"hi\"" key:"host"`, tag.StructTag("def"))
+	assert.Equal(t, `option:"variadic=true,name=Host,alias=Server,group=Net,standalone,deprecated=use addr" `+
+		`key:"host"`, tag.StructTag(""))
+
+	tag = optionspec.TagOption{Secret: true, Deprecated: "use a, then b"}
+	assert.Equal(t, `option:"secret,deprecated='use a, then b'"`, tag.StructTag(""))
+}
+
+func TestFieldType(t *testing.T) {
+	t.Parallel()
+
+	opt := optionspec.Option("hosts", "string")
+	assert.Equal(t, "string", opt.FieldType())
+
+	opt.TagOption.Variadic = true
+	assert.Equal(t, "[]string", opt.FieldType())
+}
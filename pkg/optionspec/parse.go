@@ -0,0 +1,96 @@
+package optionspec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kazhuravlev/options-gen/internal/generator"
+)
+
+const defaultTagName = "default"
+
+// ParseConfig configures Parse. The zero value reads defaults from the
+// `default` tag.
+type ParseConfig struct {
+	// DefaultsTag is the tag with default values.
+	DefaultsTag string
+	// AllVariadic makes options of slice types variadic, unless the option
+	// tag sets variadic=false.
+	AllVariadic bool
+	// Exclude skips fields, which names in title case, like Debug for the
+	// field debug, match any of the patterns.
+	Exclude []*regexp.Regexp
+}
+
+// Parse reads the options struct structName of the package of filename the
+// same way as options-gen does. Deprecated tags are converted to the current
+// ones. The second result contains warnings about the struct.
+func Parse(filename, structName string, cfg ParseConfig) (*OptionSpec, []string, error) {
+	tagName := cfg.DefaultsTag
+	if tagName == "" {
+		tagName = defaultTagName
+	}
+
+	res, err := generator.GetOptionSpec(filename, structName, tagName, cfg.AllVariadic, cfg.Exclude)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get options spec: %w", err)
+	}
+
+	spec := New(structName)
+	spec.TypeParams = strings.TrimSuffix(strings.TrimPrefix(res.Spec.TypeParamsSpec, "["), "]")
+
+	for _, opt := range res.Spec.Options {
+		spec.Add(OptionMeta{
+			Field: opt.Field,
+			Type:  opt.Type,
+			Doc:   uncomment(opt.Docstring),
+			TagOption: TagOption{
+				Mandatory:  opt.TagOption.IsRequired,
+				Validate:   opt.TagOption.GoValidator,
+				Default:    opt.TagOption.Default,
+				Variadic:   opt.TagOption.Variadic,
+				Name:       opt.TagOption.Name,
+				Env:        opt.TagOption.Env,
+				Key:        opt.TagOption.Key,
+				Secret:     opt.TagOption.Secret,
+				Aliases:    opt.TagOption.Aliases,
+				Deprecated: opt.TagOption.Deprecated,
+				Group:      opt.TagOption.Group,
+				Standalone: opt.TagOption.Standalone,
+			},
+		})
+	}
+
+	for _, imp := range res.Imports {
+		path, err := strconv.Unquote(imp.Path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid import %s: %w", imp.Path, err)
+		}
+
+		var alias string
+		if imp.Alias != nil {
+			alias = *imp.Alias
+		}
+
+		spec.Import(path, alias)
+	}
+
+	return spec, res.Warnings, nil
+}
+
+// uncomment returns the text of the comment with slashes.
+func uncomment(comment string) string {
+	if comment == "" {
+		return ""
+	}
+
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		line = strings.TrimPrefix(line, "//")
+		lines[i] = strings.TrimPrefix(line, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,84 @@
+package optionspec_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "options.go")
+	require.NoError(t, os.WriteFile(filename, []byte(`package testcase
+
+import (
+	"time"
+
+	stdlog "log"
+)
+
+type Options[T any] struct {
+	// Address of the server.
+	// Host and port.
+	addr    string        `+"`option:\"mandatory\" validate:\"required\"`"+`
+	timeout time.Duration `+"`def:\"5s\"`"+`
+	hosts   []string      `+"`option:\"variadic=true,name=Host,secret\"`"+`
+	logger  *stdlog.Logger
+	value   T
+	skipped int           `+"`option:\"-\"`"+`
+	debug   bool
+}
+`), 0o600))
+
+	spec, warnings, err := optionspec.Parse(filename, "Options", optionspec.ParseConfig{
+		DefaultsTag: "def",
+		Exclude:     []*regexp.Regexp{regexp.MustCompile("^Debug$")},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	expected := &optionspec.OptionSpec{
+		StructName: "Options",
+		TypeParams: "T any",
+		Options: []optionspec.OptionMeta{
+			{
+				Field:     "addr",
+				Type:      "string",
+				Doc:       "Address of the server.\nHost and port.",
+				TagOption: optionspec.TagOption{Mandatory: true, Validate: "required"},
+			},
+			{Field: "timeout", Type: "time.Duration", TagOption: optionspec.TagOption{Default: "5s"}},
+			{Field: "hosts", Type: "string", TagOption: optionspec.TagOption{Variadic: true, Name: "Host", Secret: true}},
+			optionspec.Option("logger", "*stdlog.Logger"),
+			optionspec.Option("value", "T"),
+		},
+		Imports: []optionspec.Import{{Path: "time"}, {Path: "log", Alias: "stdlog"}},
+	}
+	assert.Equal(t, expected, spec)
+
+	t.Run("deprecated_tags", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "options.go")
+		require.NoError(t, os.WriteFile(filename, []byte("package testcase\n\ntype Options struct {\n"+
+			"\tname string `option:\"required,not-empty\"`\n}\n"), 0o600))
+
+		spec, warnings, err := optionspec.Parse(filename, "Options", optionspec.ParseConfig{})
+		require.NoError(t, err)
+		assert.Len(t, warnings, 2)
+		assert.Equal(t, optionspec.TagOption{Mandatory: true, Validate: "required"}, spec.Options[0].TagOption)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := optionspec.Parse(filename, "Config", optionspec.ParseConfig{})
+		require.Error(t, err)
+	})
+}
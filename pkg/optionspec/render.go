@@ -0,0 +1,71 @@
+package optionspec
+
+import (
+	"fmt"
+
+	"github.com/kazhuravlev/options-gen/internal/generator"
+	"github.com/kazhuravlev/options-gen/internal/version"
+)
+
+// RenderConfig configures Render. PackageName is required.
+type RenderConfig struct {
+	PackageName string
+	// Version is written into the header of the file. The version of
+	// options-gen is used when empty.
+	Version string
+	// DefaultsTag is the tag with default values.
+	DefaultsTag string
+	// Declare makes the file declare the struct of the spec. Otherwise the
+	// struct must be declared by another file of the package.
+	Declare bool
+}
+
+// Render returns the file with the constructor, setters and Validate of the
+// spec. The file is the same as options-gen generates with default flags for
+// the struct of the spec.
+func Render(spec *OptionSpec, cfg RenderConfig) ([]byte, error) {
+	if cfg.PackageName == "" {
+		return nil, fmt.Errorf("package name is required")
+	}
+
+	ver := cfg.Version
+	if ver == "" {
+		ver = version.GetVersion()
+	}
+
+	tagName := cfg.DefaultsTag
+	if tagName == "" {
+		tagName = defaultTagName
+	}
+
+	src, err := spec.Source(cfg.PackageName, tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	res, decl, err := generator.GetSourceOptionSpec(src, spec.StructName, "", tagName, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get options spec: %w", err)
+	}
+
+	if !cfg.Declare {
+		decl = nil
+	}
+
+	out, err := generator.Render(generator.NewOptions(
+		generator.WithVersion(ver),
+		generator.WithPackageName(cfg.PackageName),
+		generator.WithOptionsStructName(spec.StructName),
+		generator.WithFileImports(res.Imports),
+		generator.WithSpec(&res.Spec),
+		generator.WithTagName(tagName),
+		generator.WithConstructorTypeRender("public"),
+		generator.WithOptionTypeName("Opt"+spec.StructName+"Setter"),
+		generator.WithStructDecl(decl),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("cannot render options: %w", err)
+	}
+
+	return out, nil
+}
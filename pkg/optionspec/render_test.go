@@ -0,0 +1,92 @@
+package optionspec_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	optionsgen "github.com/kazhuravlev/options-gen/options-gen"
+	"github.com/kazhuravlev/options-gen/pkg/optionspec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "options.go")
+	require.NoError(t, os.WriteFile(filename, []byte(`package testcase
+
+import "time"
+
+type Options struct {
+	addr    string        `+"`option:\"mandatory\" validate:\"required\"`"+`
+	timeout time.Duration `+"`default:\"5s\"`"+`
+	hosts   []string      `+"`option:\"variadic=true\"`"+`
+}
+`), 0o600))
+
+	outputs, _, err := optionsgen.Generate(optionsgen.NewOptions(
+		optionsgen.WithVersion("qa-version"),
+		optionsgen.WithInFilename(filename),
+		optionsgen.WithOutFilename(filepath.Join(dir, "options_generated.go")),
+		optionsgen.WithStructName("Options"),
+		optionsgen.WithPackageName("testcase"),
+		optionsgen.WithDefaults(optionsgen.Defaults{From: optionsgen.DefaultsFromTag, Param: ""}),
+	))
+	require.NoError(t, err)
+
+	spec, _, err := optionspec.Parse(filename, "Options", optionspec.ParseConfig{})
+	require.NoError(t, err)
+
+	content, err := optionspec.Render(spec, optionspec.RenderConfig{PackageName: "testcase", Version: "qa-version"})
+	require.NoError(t, err)
+	assert.Equal(t, string(outputs[0].Content), string(content))
+
+	t.Run("declare", func(t *testing.T) {
+		t.Parallel()
+
+		spec := optionspec.New("Config").Add(optionspec.Option("name", "string"))
+		spec.Doc = "Config of the service."
+
+		content, err := optionspec.Render(spec, optionspec.RenderConfig{
+			PackageName: "testcase",
+			Version:     "qa-version",
+			Declare:     true,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "// Config of the service.\ntype Config struct {\n\tname string\n}\n")
+		assert.Contains(t, string(content), "func WithName(opt string) OptConfigSetter {")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := optionspec.Render(optionspec.New("Config"), optionspec.RenderConfig{PackageName: "testcase"})
+		require.ErrorContains(t, err, "no options")
+
+		_, err = optionspec.Render(spec, optionspec.RenderConfig{})
+		require.ErrorContains(t, err, "package name is required")
+	})
+}
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+
+	spec := optionspec.New("Options").
+		Import("time", "").
+		Add(optionspec.OptionMeta{
+			Field:     "timeout",
+			Type:      "time.Duration",
+			Doc:       "Timeout of requests.\n\nZero disables it.",
+			TagOption: optionspec.TagOption{Default: "5s"},
+		})
+	spec.TypeParams = "T any"
+
+	src, err := spec.Source("testcase", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "package testcase\n\nimport \"time\"\n\ntype Options[T any] struct {\n"+
+		"\t// Timeout of requests.\n\t//\n\t// Zero disables it.\n"+
+		"\ttimeout time.Duration `default:\"5s\"`\n}\n", string(src))
+}
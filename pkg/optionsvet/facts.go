@@ -0,0 +1,224 @@
+package optionsvet
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const generatedHeader = "// Code generated by options-gen "
+
+// optionsFact marks options structs, which have the generated setters.
+type optionsFact struct {
+	// Constructor is empty when the constructor is not generated.
+	Constructor string
+	Mandatory   int
+	// Setters maps fields to the names of their setters.
+	Setters map[string]string
+}
+
+func (*optionsFact) AFact() {}
+
+func (f *optionsFact) String() string {
+	setters := make([]string, 0, len(f.Setters))
+	for field, setter := range f.Setters {
+		setters = append(setters, field+"="+setter)
+	}
+
+	sort.Strings(setters)
+
+	return "options(" + f.Constructor + "; " + strings.Join(setters, ", ") + ")"
+}
+
+// constructorFact marks constructors of options structs.
+type constructorFact struct {
+	Options   string
+	Mandatory int
+}
+
+func (*constructorFact) AFact() {}
+
+func (f *constructorFact) String() string {
+	return "constructor(" + f.Options + ")"
+}
+
+// setterFact marks setters, which set exactly one field.
+type setterFact struct {
+	Options string
+	Field   string
+}
+
+func (*setterFact) AFact() {}
+
+func (f *setterFact) String() string {
+	return "setter(" + f.Options + "." + f.Field + ")"
+}
+
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, generatedHeader) {
+				return ast.IsGenerated(file)
+			}
+		}
+	}
+
+	return false
+}
+
+// exportFacts exports facts for options structs, constructors and setters
+// declared in the generated file.
+func exportFacts(pass *analysis.Pass, file *ast.File) {
+	structs := make(map[*types.TypeName]*optionsFact)
+	setterTypes := make(map[*types.TypeName]*types.TypeName)
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+
+			obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+			if !ok {
+				continue
+			}
+
+			sig, ok := obj.Type().Underlying().(*types.Signature)
+			if !ok || sig.Params().Len() != 1 || sig.Results().Len() != 0 {
+				continue
+			}
+
+			ptr, ok := types.Unalias(sig.Params().At(0).Type()).(*types.Pointer)
+			if !ok {
+				continue
+			}
+
+			st := structTypeName(ptr.Elem())
+			if st == nil || st.Pkg() != pass.Pkg {
+				continue
+			}
+
+			setterTypes[obj] = st
+			if structs[st] == nil {
+				structs[st] = &optionsFact{Constructor: "", Mandatory: 0, Setters: make(map[string]string)}
+			}
+		}
+	}
+
+	setterStruct := func(t types.Type) *types.TypeName {
+		if named, ok := types.Unalias(t).(*types.Named); ok {
+			return setterTypes[named.Origin().Obj()]
+		}
+
+		return nil
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil {
+			continue
+		}
+
+		obj, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func)
+		if !ok {
+			continue
+		}
+
+		sig := obj.Type().(*types.Signature) //nolint:forcetypeassert
+		if sig.Results().Len() != 1 {
+			continue
+		}
+
+		result := sig.Results().At(0).Type()
+
+		if st := structTypeName(result); st != nil && structs[st] != nil && sig.Variadic() {
+			last := sig.Params().At(sig.Params().Len() - 1).Type().(*types.Slice) //nolint:forcetypeassert
+			if setterStruct(last.Elem()) != st {
+				continue
+			}
+
+			mandatory := sig.Params().Len() - 1
+			structs[st].Constructor = obj.Name()
+			structs[st].Mandatory = mandatory
+			pass.ExportObjectFact(obj, &constructorFact{Options: st.Name(), Mandatory: mandatory})
+
+			continue
+		}
+
+		if st := setterStruct(result); st != nil {
+			field := assignedField(funcDecl)
+			if field == "" {
+				continue
+			}
+
+			structs[st].Setters[field] = obj.Name()
+			pass.ExportObjectFact(obj, &setterFact{Options: st.Name(), Field: field})
+		}
+	}
+
+	for st, fact := range structs {
+		pass.ExportObjectFact(st, fact)
+	}
+}
+
+// structTypeName returns the declaration of the named struct type.
+func structTypeName(t types.Type) *types.TypeName {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+
+	return named.Origin().Obj()
+}
+
+// assignedField returns the field of `o` when the setter assigns exactly one
+// field. Aliases, group setters and combinators are ignored.
+func assignedField(decl *ast.FuncDecl) string {
+	fields := make(map[string]struct{})
+	ast.Inspect(decl.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+
+		for _, lhs := range assign.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "o" {
+				fields[sel.Sel.Name] = struct{}{}
+			}
+		}
+
+		return true
+	})
+
+	if len(fields) != 1 {
+		return ""
+	}
+
+	for field := range fields {
+		return field
+	}
+
+	return ""
+}
@@ -0,0 +1,512 @@
+// Package optionsvet provides the analyzer, which reports misuse of the
+// options generated by options-gen at call sites.
+package optionsvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const doc = `report misuse of the options generated by options-gen
+
+Generated files are recognized by the "Code generated by options-gen" header.
+The analyzer reports:
+
+  - options structs built as struct literals, which bypass the defaults;
+  - results of constructors, which are passed along without Validate;
+  - setters of one options struct passed to the constructor of another.
+
+Literals in package-level variables and in functions returning the options
+struct of the same package are allowed: these are the defaults and presets.
+Empty literals returned along with an error, like return Options{}, err, are
+allowed too.
+Options passed to a function of the package, which declares them, are
+expected to be validated by that package. Validation is not checked in tests.`
+
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals
+	Name:             "optionsvet",
+	Doc:              doc,
+	URL:              "https://github.com/kazhuravlev/options-gen",
+	Requires:         []*analysis.Analyzer{inspect.Analyzer},
+	Run:              run,
+	RunDespiteErrors: true,
+	FactTypes:        []analysis.Fact{new(optionsFact), new(constructorFact), new(setterFact)},
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	generated := make(map[*ast.File]bool)
+	for _, file := range pass.Files {
+		if isGenerated(file) {
+			generated[file] = true
+			exportFacts(pass, file)
+		}
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector) //nolint:forcetypeassert
+	nodes := []ast.Node{(*ast.CompositeLit)(nil), (*ast.CallExpr)(nil)}
+	insp.WithStack(nodes, func(node ast.Node, push bool, stack []ast.Node) bool {
+		if !push || generated[stack[0].(*ast.File)] { //nolint:forcetypeassert
+			return true
+		}
+
+		switch node := node.(type) {
+		case *ast.CompositeLit:
+			checkLiteral(pass, node, stack)
+		case *ast.CallExpr:
+			checkCall(pass, node, stack)
+		}
+
+		return true
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+func checkLiteral(pass *analysis.Pass, lit *ast.CompositeLit, stack []ast.Node) {
+	st := structTypeName(typeOf(pass, lit))
+	if st == nil {
+		return
+	}
+
+	var fact optionsFact
+	if !pass.ImportObjectFact(st, &fact) {
+		return
+	}
+
+	if st.Pkg() == pass.Pkg && isDefaults(pass, st, stack) {
+		return
+	}
+
+	if isZeroResult(pass, lit, stack) {
+		return
+	}
+
+	name := qualifiedName(pass, st.Pkg(), st.Name())
+	if fact.Constructor == "" {
+		pass.Reportf(lit.Pos(), "%s is built as a struct literal: defaults are not applied", name)
+
+		return
+	}
+
+	constructor := qualifiedName(pass, st.Pkg(), fact.Constructor)
+	diag := analysis.Diagnostic{ //nolint:exhaustruct
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: name + " is built as a struct literal: defaults are not applied, use " + constructor,
+	}
+
+	// NOTE: &NewOptions() is not valid, and mandatory values are unknown.
+	_, isAddr := stack[len(stack)-2].(*ast.UnaryExpr)
+	canCall := st.Pkg() == pass.Pkg || token.IsExported(fact.Constructor)
+	if len(lit.Elts) == 0 && fact.Mandatory == 0 && !isAddr && canCall {
+		var call string
+		switch litType := lit.Type.(type) {
+		case *ast.Ident:
+			call = fact.Constructor + "()"
+		case *ast.SelectorExpr:
+			if pkg, ok := litType.X.(*ast.Ident); ok {
+				call = pkg.Name + "." + fact.Constructor + "()"
+			}
+		}
+
+		if call != "" {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   "Use " + constructor,
+				TextEdits: []analysis.TextEdit{{Pos: lit.Pos(), End: lit.End(), NewText: []byte(call)}},
+			}}
+		}
+	}
+
+	pass.Report(diag)
+}
+
+// isDefaults reports whether the literal is a part of package-level variable
+// or is returned by a function, which returns the options struct.
+func isDefaults(pass *analysis.Pass, st *types.TypeName, stack []ast.Node) bool {
+	switch decl := stack[1].(type) {
+	case *ast.GenDecl:
+		return true
+	case *ast.FuncDecl:
+		obj, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+		if !ok {
+			return false
+		}
+
+		results := obj.Type().(*types.Signature).Results() //nolint:forcetypeassert
+
+		return results.Len() == 1 && structTypeName(results.At(0).Type()) == st
+	}
+
+	return false
+}
+
+// isZeroResult reports whether the literal is an empty value returned along
+// with an error, like return Options{}, err.
+func isZeroResult(pass *analysis.Pass, lit *ast.CompositeLit, stack []ast.Node) bool {
+	if len(lit.Elts) != 0 {
+		return false
+	}
+
+	if _, ok := stack[len(stack)-2].(*ast.ReturnStmt); !ok {
+		return false
+	}
+
+	_, sig := enclosingFunc(pass, stack)
+	if sig == nil {
+		return false
+	}
+
+	results := sig.Results()
+
+	return results.Len() > 1 && isErrorType(results.At(results.Len()-1).Type())
+}
+
+func checkCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	fn := calleeFunc(pass, call)
+	if fn == nil {
+		return
+	}
+
+	var fact constructorFact
+	if !pass.ImportObjectFact(fn, &fact) {
+		return
+	}
+
+	checkSetters(pass, call, fn, fact)
+	checkValidated(pass, call, fn, stack)
+}
+
+// checkSetters reports setters of other options structs.
+func checkSetters(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, fact constructorFact) {
+	if len(call.Args) <= fact.Mandatory {
+		return
+	}
+
+	for _, arg := range call.Args[fact.Mandatory:] {
+		argCall, ok := ast.Unparen(arg).(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		setter := calleeFunc(pass, argCall)
+		if setter == nil {
+			continue
+		}
+
+		var setterFact setterFact
+		if !pass.ImportObjectFact(setter, &setterFact) {
+			continue
+		}
+
+		if setter.Pkg() == fn.Pkg() && setterFact.Options == fact.Options {
+			continue
+		}
+
+		diag := analysis.Diagnostic{ //nolint:exhaustruct
+			Pos: arg.Pos(),
+			End: arg.End(),
+			Message: "setter " + qualifiedName(pass, setter.Pkg(), setter.Name()) +
+				" of " + qualifiedName(pass, setter.Pkg(), setterFact.Options) +
+				" is passed to " + qualifiedName(pass, fn.Pkg(), fn.Name()),
+		}
+
+		if name := setterFor(pass, fn.Pkg(), fact.Options, setterFact.Field); name != "" && setter.Pkg() == fn.Pkg() {
+			if ident := funcIdent(argCall.Fun); ident != nil {
+				diag.SuggestedFixes = []analysis.SuggestedFix{{
+					Message:   "Use " + qualifiedName(pass, fn.Pkg(), name),
+					TextEdits: []analysis.TextEdit{{Pos: ident.Pos(), End: ident.End(), NewText: []byte(name)}},
+				}}
+			}
+		}
+
+		pass.Report(diag)
+	}
+}
+
+// setterFor returns the setter of the field of the options struct.
+func setterFor(pass *analysis.Pass, pkg *types.Package, structName, field string) string {
+	obj := pkg.Scope().Lookup(structName)
+	if obj == nil {
+		return ""
+	}
+
+	var fact optionsFact
+	if !pass.ImportObjectFact(obj, &fact) {
+		return ""
+	}
+
+	return fact.Setters[field]
+}
+
+// checkValidated reports options, which are assigned to the variable that is
+// never validated, or are passed to a function of another package. The
+// package, which declares the options, is responsible for the validation.
+func checkValidated(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, stack []ast.Node) {
+	if strings.TrimSuffix(pass.Pkg.Path(), "_test") == fn.Pkg().Path() {
+		return
+	}
+
+	// NOTE: tests usually check the options without validation.
+	if strings.HasSuffix(pass.Fset.File(call.Pos()).Name(), "_test.go") {
+		return
+	}
+
+	name := qualifiedName(pass, fn.Pkg(), fn.Name())
+
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.AssignStmt:
+		if len(parent.Lhs) != len(parent.Rhs) {
+			return
+		}
+
+		for i, rhs := range parent.Rhs {
+			if rhs != call {
+				continue
+			}
+
+			ident, ok := parent.Lhs[i].(*ast.Ident)
+			if !ok {
+				return
+			}
+
+			checkVarValidated(pass, fn, ident, name, parent, stack)
+		}
+	case *ast.ValueSpec:
+		for i, value := range parent.Values {
+			if value == call && i < len(parent.Names) {
+				checkVarValidated(pass, fn, parent.Names[i], name, nil, stack)
+			}
+		}
+	case *ast.CallExpr:
+		if parent.Fun == call {
+			return
+		}
+
+		if callee := calleeFunc(pass, parent); callee != nil && callee.Pkg() == fn.Pkg() {
+			return
+		}
+
+		pass.Reportf(call.Pos(), "options returned by %s are passed along without validation", name)
+	case *ast.KeyValueExpr:
+		if parent.Value == call {
+			pass.Reportf(call.Pos(), "options returned by %s are passed along without validation", name)
+		}
+	}
+}
+
+func checkVarValidated(
+	pass *analysis.Pass,
+	fn *types.Func,
+	ident *ast.Ident,
+	name string,
+	stmt ast.Stmt,
+	stack []ast.Node,
+) {
+	if ident.Name == "_" {
+		return
+	}
+
+	obj := pass.TypesInfo.ObjectOf(ident)
+	if obj == nil {
+		return
+	}
+
+	body, sig := enclosingFunc(pass, stack)
+	if body == nil || isValidated(pass, body, obj, fn.Pkg()) {
+		return
+	}
+
+	diag := analysis.Diagnostic{ //nolint:exhaustruct
+		Pos:     ident.Pos(),
+		End:     ident.End(),
+		Message: "options returned by " + name + " are not validated",
+	}
+
+	if fix, ok := validateFix(pass, ident.Name, stmt, sig, stack); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+
+	pass.Report(diag)
+}
+
+// enclosingFunc returns the body and the signature of the innermost function.
+func enclosingFunc(pass *analysis.Pass, stack []ast.Node) (*ast.BlockStmt, *types.Signature) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncLit:
+			sig, _ := typeOf(pass, fn).(*types.Signature)
+
+			return fn.Body, sig
+		case *ast.FuncDecl:
+			obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+			if !ok {
+				return fn.Body, nil
+			}
+
+			return fn.Body, obj.Type().(*types.Signature) //nolint:forcetypeassert
+		}
+	}
+
+	return nil, nil
+}
+
+// isValidated reports whether the variable is validated or is passed to a
+// function of the package, which declares the options.
+func isValidated(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object, pkg *types.Package) bool {
+	isVar := func(expr ast.Expr) bool {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+
+		return ok && pass.TypesInfo.ObjectOf(ident) == obj
+	}
+
+	validated := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.SelectorExpr:
+			validated = validated || node.Sel.Name == "Validate" && isVar(node.X)
+		case *ast.CallExpr:
+			if callee := calleeFunc(pass, node); callee != nil && callee.Pkg() == pkg {
+				for _, arg := range node.Args {
+					validated = validated || isVar(arg)
+				}
+			}
+		}
+
+		return !validated
+	})
+
+	return validated
+}
+
+// validateFix inserts the Validate call after the statement, when the
+// function returns an error and zero values of other results are known.
+func validateFix(
+	pass *analysis.Pass,
+	varName string,
+	stmt ast.Stmt,
+	sig *types.Signature,
+	stack []ast.Node,
+) (analysis.SuggestedFix, bool) {
+	if stmt == nil || sig == nil {
+		return analysis.SuggestedFix{}, false
+	}
+
+	if _, ok := stack[len(stack)-3].(*ast.BlockStmt); !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	results := sig.Results()
+	if results.Len() == 0 || !isErrorType(results.At(results.Len()-1).Type()) {
+		return analysis.SuggestedFix{}, false
+	}
+
+	values := make([]string, 0, results.Len())
+	for i := range results.Len() - 1 {
+		zero := zeroValue(results.At(i).Type())
+		if zero == "" {
+			return analysis.SuggestedFix{}, false
+		}
+
+		values = append(values, zero)
+	}
+
+	values = append(values, "err")
+
+	// NOTE: the check is inserted on the next line to keep the trailing
+	// comment of the statement.
+	file := pass.Fset.File(stmt.End())
+	line := file.Line(stmt.End())
+	if line >= file.LineCount() {
+		return analysis.SuggestedFix{}, false
+	}
+
+	pos := file.LineStart(line + 1)
+	indent := strings.Repeat("\t", pass.Fset.Position(stmt.Pos()).Column-1)
+	text := indent + "if err := " + varName + ".Validate(); err != nil {\n" +
+		indent + "\treturn " + strings.Join(values, ", ") + "\n" +
+		indent + "}\n"
+
+	return analysis.SuggestedFix{
+		Message:   "Validate the options",
+		TextEdits: []analysis.TextEdit{{Pos: pos, End: pos, NewText: []byte(text)}},
+	}, true
+}
+
+func isErrorType(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// zeroValue returns the expression of the zero value or an empty string when
+// it depends on the type name.
+func zeroValue(t types.Type) string {
+	if _, ok := t.(*types.TypeParam); ok {
+		return ""
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		case u.Kind() == types.UnsafePointer:
+			return "nil"
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return "nil"
+	}
+
+	return ""
+}
+
+func calleeFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	return fn.Origin()
+}
+
+// funcIdent returns the identifier of the called function.
+func funcIdent(fun ast.Expr) *ast.Ident {
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	case *ast.IndexExpr:
+		return funcIdent(fun.X)
+	case *ast.IndexListExpr:
+		return funcIdent(fun.X)
+	}
+
+	return nil
+}
+
+func typeOf(pass *analysis.Pass, expr ast.Expr) types.Type {
+	t := pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return types.Typ[types.Invalid]
+	}
+
+	return t
+}
+
+func qualifiedName(pass *analysis.Pass, pkg *types.Package, name string) string {
+	if pkg == nil || pkg == pass.Pkg {
+		return name
+	}
+
+	return pkg.Name() + "." + name
+}
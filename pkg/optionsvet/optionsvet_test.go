@@ -0,0 +1,14 @@
+package optionsvet_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/optionsvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), optionsvet.Analyzer, "a", "b", "c")
+}
@@ -0,0 +1,28 @@
+package a
+
+type Options struct { // want Options:`options\(NewOptions; name=WithName, timeout=WithTimeout\)`
+	timeout int
+	name    string
+}
+
+type Other struct { // want Other:`options\(NewOther; timeout=WithOtherTimeout\)`
+	addr    string
+	timeout int
+}
+
+var defaultOptions = Options{timeout: 1}
+
+func getDefaultOptions() Options {
+	return Options{timeout: 2}
+}
+
+func inPackage() {
+	opts := Options{} // want `Options is built as a struct literal: defaults are not applied, use NewOptions`
+	_ = opts
+}
+
+func inPackageNotValidated() Options {
+	opts := NewOptions(WithTimeout(1))
+
+	return opts
+}
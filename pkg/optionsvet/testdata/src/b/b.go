@@ -0,0 +1,59 @@
+package b
+
+import (
+	"errors"
+
+	"a"
+)
+
+func literal() {
+	_ = a.Options{}  // want `a.Options is built as a struct literal: defaults are not applied, use a.NewOptions`
+	_ = &a.Options{} // want `a.Options is built as a struct literal: defaults are not applied, use a.NewOptions`
+	_ = a.Other{}    // want `a.Other is built as a struct literal: defaults are not applied, use a.NewOther`
+}
+
+func zeroOnError(fail bool) (a.Options, error) {
+	if fail {
+		return a.Options{}, errors.New("fail")
+	}
+
+	get := func() (a.Options, error) {
+		return a.Options{}, nil
+	}
+
+	return get()
+}
+
+func zeroWithoutError() a.Options {
+	return a.Options{} // want `a.Options is built as a struct literal: defaults are not applied, use a.NewOptions`
+}
+
+func notValidated() (int, error) {
+	opts := a.NewOptions(a.WithTimeout(1)) // want `options returned by a.NewOptions are not validated`
+	use(opts)
+
+	return 0, nil
+}
+
+func notValidatedNoError() {
+	opts := a.NewOptions() // want `options returned by a.NewOptions are not validated`
+	use(opts)
+}
+
+func validated() error {
+	opts := a.NewOptions()
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	use(opts)
+
+	return nil
+}
+
+func passed() {
+	use(a.NewOptions()) // want `options returned by a.NewOptions are passed along without validation`
+	_ = a.NewOptions().Validate()
+}
+
+func use(a.Options) {}
@@ -0,0 +1,8 @@
+package c
+
+import "a"
+
+func mismatch() {
+	_ = a.NewOther("addr", a.WithTimeout(1)) // want `setter a.WithTimeout of a.Options is passed to a.NewOther`
+	_ = a.NewOther("addr", a.WithName("x"))  // want `setter a.WithName of a.Options is passed to a.NewOther`
+}
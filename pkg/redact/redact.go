@@ -0,0 +1,21 @@
+package redact
+
+import "strconv"
+
+// Mask is used instead of the secret values.
+const Mask = "***"
+
+// Items returns a summary for collections like slices and maps.
+func Items(n int) string {
+	return "[" + strconv.Itoa(n) + " items]"
+}
+
+// Set returns a summary for values that can not be printed, like functions
+// and channels.
+func Set(isSet bool) string {
+	if isSet {
+		return "set"
+	}
+
+	return "unset"
+}
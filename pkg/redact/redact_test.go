@@ -0,0 +1,22 @@
+package redact_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/options-gen/pkg/redact"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItems(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "[0 items]", redact.Items(0))
+	assert.Equal(t, "[3 items]", redact.Items(3))
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "set", redact.Set(true))
+	assert.Equal(t, "unset", redact.Set(false))
+}